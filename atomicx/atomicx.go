@@ -0,0 +1,145 @@
+package atomicx
+
+import "sync/atomic"
+
+// Box holds a value of type T that can be read and replaced atomically.
+// The zero Box holds the zero value of T. A Box must not be copied after first use.
+type Box[T any] struct {
+	p atomic.Pointer[T]
+}
+
+// NewBox creates a Box holding value.
+func NewBox[T any](value T) *Box[T] {
+	b := &Box[T]{}
+	b.p.Store(&value)
+	return b
+}
+
+// Load returns the current value.
+func (b *Box[T]) Load() T {
+	if p := b.p.Load(); p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
+
+// Store replaces the current value.
+func (b *Box[T]) Store(value T) {
+	b.p.Store(&value)
+}
+
+// Swap replaces the current value and returns the previous one.
+func (b *Box[T]) Swap(value T) T {
+	if old := b.p.Swap(&value); old != nil {
+		return *old
+	}
+	var zero T
+	return zero
+}
+
+// Update atomically replaces the current value with fn applied to it and returns the new value.
+// fn may be called more than once if other goroutines write concurrently.
+func (b *Box[T]) Update(fn func(T) T) T {
+	for {
+		old := b.p.Load()
+		var current T
+		if old != nil {
+			current = *old
+		}
+		next := fn(current)
+		if b.p.CompareAndSwap(old, &next) {
+			return next
+		}
+	}
+}
+
+type stamped[T any] struct {
+	value   T
+	version uint64
+}
+
+// Versioned holds a value of type T together with a version that increases by one on every write.
+// The zero Versioned holds the zero value of T at version 0. A Versioned must not be copied after first use.
+type Versioned[T any] struct {
+	p atomic.Pointer[stamped[T]]
+}
+
+// NewVersioned creates a Versioned holding value at version 0.
+func NewVersioned[T any](value T) *Versioned[T] {
+	v := &Versioned[T]{}
+	v.p.Store(&stamped[T]{value: value})
+	return v
+}
+
+func (v *Versioned[T]) load() *stamped[T] {
+	if s := v.p.Load(); s != nil {
+		return s
+	}
+	return &stamped[T]{}
+}
+
+// Load returns the current value and its version. Both are read together, so the version
+// always belongs to the value.
+func (v *Versioned[T]) Load() (T, uint64) {
+	s := v.load()
+	return s.value, s.version
+}
+
+// Version returns the current version.
+func (v *Versioned[T]) Version() uint64 {
+	return v.load().version
+}
+
+// Store replaces the current value and returns its new version.
+func (v *Versioned[T]) Store(value T) uint64 {
+	for {
+		old := v.p.Load()
+		next := &stamped[T]{value: value, version: versionAfter(old)}
+		if v.p.CompareAndSwap(old, next) {
+			return next.version
+		}
+	}
+}
+
+// Update atomically replaces the current value with fn applied to it and returns the new value
+// and its version. fn may be called more than once if other goroutines write concurrently.
+func (v *Versioned[T]) Update(fn func(T) T) (T, uint64) {
+	for {
+		old := v.p.Load()
+		var current T
+		if old != nil {
+			current = old.value
+		}
+		next := &stamped[T]{value: fn(current), version: versionAfter(old)}
+		if v.p.CompareAndSwap(old, next) {
+			return next.value, next.version
+		}
+	}
+}
+
+// CompareAndSwap replaces the current value only if its version is still version, and returns
+// the version after the call and whether the value was replaced.
+func (v *Versioned[T]) CompareAndSwap(version uint64, value T) (uint64, bool) {
+	for {
+		old := v.p.Load()
+		current := uint64(0)
+		if old != nil {
+			current = old.version
+		}
+		if current != version {
+			return current, false
+		}
+		next := &stamped[T]{value: value, version: version + 1}
+		if v.p.CompareAndSwap(old, next) {
+			return next.version, true
+		}
+	}
+}
+
+func versionAfter[T any](s *stamped[T]) uint64 {
+	if s == nil {
+		return 1
+	}
+	return s.version + 1
+}
@@ -0,0 +1,76 @@
+package atomicx
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestBox(t *testing.T) {
+	var b Box[[]int]
+	if b.Load() != nil {
+		t.Errorf("expected zero Box to hold nil, got %v", b.Load())
+	}
+	b.Store([]int{1})
+	if old := b.Swap([]int{2}); len(old) != 1 || old[0] != 1 {
+		t.Errorf("expected Swap to return [1], got %v", old)
+	}
+
+	var iface Box[any]
+	iface.Store(1)
+	iface.Store("mixed types are fine")
+	if iface.Load() != "mixed types are fine" {
+		t.Errorf("expected stored string, got %v", iface.Load())
+	}
+
+	counter := NewBox(0)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				counter.Update(func(n int) int { return n + 1 })
+			}
+		}()
+	}
+	wg.Wait()
+	if counter.Load() != 8000 {
+		t.Errorf("expected 8000, got %d", counter.Load())
+	}
+}
+
+func TestVersioned(t *testing.T) {
+	var v Versioned[string]
+	if value, version := v.Load(); value != "" || version != 0 {
+		t.Errorf("expected zero value at version 0, got %q at %d", value, version)
+	}
+	if version := v.Store("a"); version != 1 {
+		t.Errorf("expected version 1, got %d", version)
+	}
+	if value, version := v.Update(func(s string) string { return s + "b" }); value != "ab" || version != 2 {
+		t.Errorf("expected ab at version 2, got %q at %d", value, version)
+	}
+
+	if version, ok := v.CompareAndSwap(1, "stale"); ok || version != 2 {
+		t.Errorf("expected stale CompareAndSwap to fail at version 2, got %d, %v", version, ok)
+	}
+	if version, ok := v.CompareAndSwap(2, "c"); !ok || version != 3 {
+		t.Errorf("expected CompareAndSwap to succeed with version 3, got %d, %v", version, ok)
+	}
+
+	fresh := NewVersioned(10)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				fresh.Update(func(n int) int { return n + 1 })
+			}
+		}()
+	}
+	wg.Wait()
+	if value, version := fresh.Load(); value != 8010 || version != 8000 {
+		t.Errorf("expected 8010 at version 8000, got %d at %d", value, version)
+	}
+}
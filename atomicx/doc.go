@@ -0,0 +1,44 @@
+// Package atomicx provides typed atomic registers.
+//
+// Box is a typed alternative to atomic.Value: it holds any value, including interfaces and
+// structs, without type assertions or the atomic.Value rule that every stored value must have
+// the same concrete type. The zero Box is ready to use and holds the zero value of T.
+//
+//	var cfg atomicx.Box[Config]
+//	cfg.Store(loadConfig())
+//
+//	// Readers never block
+//	timeout := cfg.Load().Timeout
+//
+//	// Read-modify-write without a mutex
+//	cfg.Update(func(c Config) Config {
+//	    c.Timeout *= 2
+//	    return c
+//	})
+//
+// # Versions
+//
+// Versioned also stamps every write with a monotonically increasing version, so readers can
+// tell whether the value changed since they last saw it and writers can apply optimistic
+// updates that fail if someone else wrote in between:
+//
+//	var routes atomicx.Versioned[[]Route]
+//
+//	table, version := routes.Load()
+//	next := rebuild(table)
+//	if _, ok := routes.CompareAndSwap(version, next); !ok {
+//	    // Someone else updated the table meanwhile; reload and retry.
+//	}
+//
+// # Update Functions
+//
+// Update retries its function until its result is stored without interference, so under
+// contention the function may run more than once. It must be free of side effects and must not
+// modify the value it receives in place; return a modified copy instead.
+//
+// # Thread Safety
+//
+// All methods are safe for concurrent use and lock-free. Values are stored behind pointers, so
+// Load never copies a partially written value, but mutable values such as slices and maps are
+// shared between readers and must be treated as read-only once stored.
+package atomicx
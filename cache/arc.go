@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"container/list"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// arcList identifies which of the four ARC lists an entry belongs to.
+type arcList uint8
+
+const (
+	inT1 arcList = iota // cached, seen once recently
+	inT2                // cached, seen at least twice recently
+	inB1                // ghost, recently evicted from T1
+	inB2                // ghost, recently evicted from T2
+)
+
+// ARC is a cache implementing the Adaptive Replacement Cache policy.
+// It balances recency and frequency by keeping two LRU lists of cached entries
+// (seen once / seen repeatedly) and two ghost lists of recently evicted keys,
+// adapting the split between them to the workload. Scans only churn the
+// "seen once" side, so the frequently used set survives them.
+//
+// Ghost entries only hold keys, so ARC tracks up to twice its capacity in keys.
+type ARC[K comparable, V any] struct {
+	capacity int
+	p        int
+	lists    [4]*list.List
+	items    map[K]*list.Element
+}
+
+// arcEntry is the payload stored in the ARC lists.
+type arcEntry[K comparable, V any] struct {
+	key   K
+	value V
+	where arcList
+}
+
+// NewARC creates a new ARC cache holding at most capacity entries.
+// If capacity is less than 1, it is set to 1.
+func NewARC[K comparable, V any](capacity int) *ARC[K, V] {
+	return &ARC[K, V]{
+		capacity: normalizeCapacity(capacity),
+		lists:    [4]*list.List{list.New(), list.New(), list.New(), list.New()},
+		items:    make(map[K]*list.Element),
+	}
+}
+
+// Get retrieves the value for a key and records the access.
+// Ghost entries are misses.
+func (c *ARC[K, V]) Get(key K) optional.Option[V] {
+	el, ok := c.items[key]
+	if !ok {
+		return optional.None[V]()
+	}
+	e := el.Value.(*arcEntry[K, V])
+	if e.where == inB1 || e.where == inB2 {
+		return optional.None[V]()
+	}
+	c.moveTo(el, inT2)
+	return optional.Some(e.value)
+}
+
+// Set inserts or updates a key-value pair and records the access.
+// Inserting a key found in a ghost list adapts the balance between recency and frequency.
+func (c *ARC[K, V]) Set(key K, value V) {
+	t1, t2, b1, b2 := c.lists[inT1], c.lists[inT2], c.lists[inB1], c.lists[inB2]
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*arcEntry[K, V])
+		switch e.where {
+		case inB1:
+			c.p = min(c.capacity, c.p+max(b2.Len()/b1.Len(), 1))
+			c.replace(false)
+		case inB2:
+			c.p = max(0, c.p-max(b1.Len()/b2.Len(), 1))
+			c.replace(true)
+		}
+		e.value = value
+		c.moveTo(el, inT2)
+		return
+	}
+
+	l1 := t1.Len() + b1.Len()
+	total := l1 + t2.Len() + b2.Len()
+	if l1 >= c.capacity {
+		if t1.Len() < c.capacity {
+			c.removeOldest(inB1)
+			c.replace(false)
+		} else {
+			c.removeOldest(inT1)
+		}
+	} else if total >= c.capacity {
+		if total >= 2*c.capacity {
+			c.removeOldest(inB2)
+		}
+		c.replace(false)
+	}
+
+	c.items[key] = t1.PushFront(&arcEntry[K, V]{key: key, value: value, where: inT1})
+}
+
+// Delete removes a key from the cache, including any ghost entry.
+// Returns true only if the key was cached.
+func (c *ARC[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	e := el.Value.(*arcEntry[K, V])
+	c.lists[e.where].Remove(el)
+	delete(c.items, key)
+	return e.where == inT1 || e.where == inT2
+}
+
+// Len returns the number of cached entries.
+func (c *ARC[K, V]) Len() int {
+	return c.lists[inT1].Len() + c.lists[inT2].Len()
+}
+
+// Cap returns the maximum number of cached entries.
+func (c *ARC[K, V]) Cap() int {
+	return c.capacity
+}
+
+// replace evicts a cached entry into the matching ghost list,
+// choosing between T1 and T2 based on the adaptive target p.
+// It does nothing while the cache still has free room.
+func (c *ARC[K, V]) replace(hitB2 bool) {
+	if c.Len() < c.capacity {
+		return
+	}
+	t1Len := c.lists[inT1].Len()
+	if t1Len > 0 && (t1Len > c.p || (hitB2 && t1Len == c.p) || c.lists[inT2].Len() == 0) {
+		c.demote(inT1, inB1)
+		return
+	}
+	if c.lists[inT2].Len() > 0 {
+		c.demote(inT2, inB2)
+	}
+}
+
+// demote moves the least recently used entry of a cached list to a ghost list, dropping its value.
+func (c *ARC[K, V]) demote(from, to arcList) {
+	el := c.lists[from].Back()
+	e := el.Value.(*arcEntry[K, V])
+	var zero V
+	e.value = zero
+	c.moveTo(el, to)
+}
+
+// removeOldest drops the least recently used entry of a list entirely.
+func (c *ARC[K, V]) removeOldest(from arcList) {
+	el := c.lists[from].Back()
+	if el == nil {
+		return
+	}
+	c.lists[from].Remove(el)
+	delete(c.items, el.Value.(*arcEntry[K, V]).key)
+}
+
+// moveTo moves an entry to the front of the given list.
+func (c *ARC[K, V]) moveTo(el *list.Element, to arcList) {
+	e := el.Value.(*arcEntry[K, V])
+	if e.where == to {
+		c.lists[to].MoveToFront(el)
+		return
+	}
+	c.lists[e.where].Remove(el)
+	e.where = to
+	c.items[e.key] = c.lists[to].PushFront(e)
+}
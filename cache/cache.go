@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// Policy is a fixed-capacity cache with a specific eviction policy.
+// When the cache is full, inserting a new key evicts an entry chosen by the policy.
+//
+// Implementations in this package are not safe for concurrent use.
+// Guard them with a sync.Mutex when shared between goroutines.
+type Policy[K comparable, V any] interface {
+	// Get retrieves the value for a key and records the access.
+	// Returns an Option containing the value if cached, None otherwise.
+	Get(key K) optional.Option[V]
+
+	// Set inserts or updates a key-value pair, evicting an entry if the cache is full.
+	Set(key K, value V)
+
+	// Delete removes a key from the cache.
+	// Returns true if the key was cached and removed, false otherwise.
+	Delete(key K) bool
+
+	// Len returns the number of cached entries.
+	Len() int
+
+	// Cap returns the maximum number of cached entries.
+	Cap() int
+}
+
+// Stats contains the outcome of replaying an access trace against a Policy.
+type Stats struct {
+	Hits   int64
+	Misses int64
+}
+
+// HitRatio returns the fraction of accesses that were hits, or 0 if there were no accesses.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// String returns a string representation of the Stats.
+func (s Stats) String() string {
+	return fmt.Sprintf("Stats{hits=%d, misses=%d, ratio=%.4f}", s.Hits, s.Misses, s.HitRatio())
+}
+
+// Replay runs an access trace against the policy, the way a read-through cache would:
+// each key is looked up and, on a miss, the value produced by load is inserted.
+// It is useful to compare hit rates of several policies on a recorded production trace.
+func Replay[K comparable, V any](p Policy[K, V], trace []K, load func(K) V) Stats {
+	var stats Stats
+	for _, key := range trace {
+		if p.Get(key).IsPresent() {
+			stats.Hits++
+			continue
+		}
+		stats.Misses++
+		p.Set(key, load(key))
+	}
+	return stats
+}
+
+// normalizeCapacity ensures a policy holds at least one entry.
+func normalizeCapacity(capacity int) int {
+	if capacity < 1 {
+		return 1
+	}
+	return capacity
+}
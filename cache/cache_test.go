@@ -0,0 +1,170 @@
+package cache
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func policies(capacity int) map[string]Policy[int, int] {
+	return map[string]Policy[int, int]{
+		"LRU":  NewLRU[int, int](capacity),
+		"LRU2": NewLRU2[int, int](capacity),
+		"ARC":  NewARC[int, int](capacity),
+	}
+}
+
+func TestPolicyBasic(t *testing.T) {
+	for name, p := range policies(2) {
+		t.Run(name, func(t *testing.T) {
+			p.Set(1, 10)
+			p.Set(2, 20)
+
+			if opt := p.Get(1); !opt.IsPresent() || opt.MustGet() != 10 {
+				t.Errorf("expected 1=10, got %v", opt)
+			}
+			if p.Len() != 2 || p.Cap() != 2 {
+				t.Errorf("expected len 2 and cap 2, got %d and %d", p.Len(), p.Cap())
+			}
+
+			p.Set(1, 11)
+			if opt := p.Get(1); !opt.IsPresent() || opt.MustGet() != 11 {
+				t.Errorf("expected updated 1=11, got %v", opt)
+			}
+
+			p.Set(3, 30)
+			if p.Len() != 2 {
+				t.Errorf("expected len 2 after eviction, got %d", p.Len())
+			}
+			if !p.Get(3).IsPresent() {
+				t.Error("expected newly inserted key to be cached")
+			}
+
+			if !p.Delete(3) {
+				t.Error("expected Delete to return true for cached key")
+			}
+			if p.Delete(3) {
+				t.Error("expected Delete to return false for missing key")
+			}
+		})
+	}
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+
+	if c.Get("b").IsPresent() {
+		t.Error("expected b to be evicted")
+	}
+	if !c.Get("a").IsPresent() || !c.Get("c").IsPresent() {
+		t.Error("expected a and c to be cached")
+	}
+}
+
+func TestLRU2EvictsOneOffKeysFirst(t *testing.T) {
+	c := NewLRU2[string, int](2)
+	c.Set("hot", 1)
+	c.Get("hot")
+	c.Set("scan1", 0)
+	c.Set("scan2", 0)
+
+	if !c.Get("hot").IsPresent() {
+		t.Error("expected key accessed twice to survive a scan")
+	}
+	if c.Get("scan1").IsPresent() {
+		t.Error("expected older one-off key to be evicted")
+	}
+}
+
+func TestScanResistance(t *testing.T) {
+	const capacity = 100
+	hot := make([]int, 0, capacity/2)
+	for i := range capacity / 2 {
+		hot = append(hot, i)
+	}
+
+	for name, p := range policies(capacity) {
+		t.Run(name, func(t *testing.T) {
+			for range 3 {
+				for _, k := range hot {
+					if !p.Get(k).IsPresent() {
+						p.Set(k, k)
+					}
+				}
+			}
+			for k := 1000; k < 1000+10*capacity; k++ {
+				p.Set(k, k)
+			}
+
+			survivors := 0
+			for _, k := range hot {
+				if p.Get(k).IsPresent() {
+					survivors++
+				}
+			}
+			if name != "LRU" && survivors != len(hot) {
+				t.Errorf("expected the hot set to survive the scan, %d/%d left", survivors, len(hot))
+			}
+			if name == "LRU" && survivors != 0 {
+				t.Errorf("expected LRU to lose the hot set, %d left", survivors)
+			}
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	c := NewLRU[int, int](10)
+	stats := Replay[int, int](c, []int{1, 2, 1, 3, 1}, func(k int) int { return k })
+
+	if stats.Hits != 2 || stats.Misses != 3 {
+		t.Errorf("expected 2 hits and 3 misses, got %v", stats)
+	}
+	if ratio := stats.HitRatio(); ratio != 0.4 {
+		t.Errorf("expected hit ratio 0.4, got %v", ratio)
+	}
+}
+
+// scanTrace builds a trace of Zipf-distributed accesses to a hot set,
+// interrupted by sequential scans of keys that are never reused.
+func scanTrace(n int) []int {
+	r := rand.New(rand.NewSource(1))
+	zipf := rand.NewZipf(r, 1.1, 1, 10_000)
+	trace := make([]int, 0, n)
+	next := 1_000_000
+	for len(trace) < n {
+		for range 5_000 {
+			trace = append(trace, int(zipf.Uint64()))
+		}
+		for range 2_000 {
+			trace = append(trace, next)
+			next++
+		}
+	}
+	return trace[:n]
+}
+
+func benchmarkHitRate(b *testing.B, newPolicy func() Policy[int, int]) {
+	trace := scanTrace(200_000)
+	var stats Stats
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stats = Replay(newPolicy(), trace, func(k int) int { return k })
+	}
+	b.ReportMetric(stats.HitRatio()*100, "hit%")
+}
+
+func BenchmarkHitRateLRU(b *testing.B) {
+	benchmarkHitRate(b, func() Policy[int, int] { return NewLRU[int, int](1_000) })
+}
+
+func BenchmarkHitRateLRU2(b *testing.B) {
+	benchmarkHitRate(b, func() Policy[int, int] { return NewLRU2[int, int](1_000) })
+}
+
+func BenchmarkHitRateARC(b *testing.B) {
+	benchmarkHitRate(b, func() Policy[int, int] { return NewARC[int, int](1_000) })
+}
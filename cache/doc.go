@@ -0,0 +1,36 @@
+/*
+Package cache provides fixed-capacity caches with interchangeable eviction policies.
+
+All policies implement the Policy interface, so they can be swapped without touching
+call sites:
+
+  - LRU: evicts the least recently used entry. Simple, but a single scan of one-off
+    keys can flush the whole working set.
+  - LRUK (and NewLRU2): evicts the entry whose K-th most recent access is the oldest.
+    Keys seen only once are evicted before keys seen repeatedly.
+  - ARC: Adaptive Replacement Cache. Balances recency and frequency and tunes itself
+    to the workload using ghost lists of recently evicted keys.
+
+Example usage:
+
+	var c cache.Policy[string, []byte] = cache.NewARC[string, []byte](10_000)
+
+	c.Set("user:42", payload)
+	if data := c.Get("user:42"); data.IsPresent() {
+		serve(data.Get())
+	}
+
+Comparing policies on a recorded access trace:
+
+	for name, p := range map[string]cache.Policy[string, struct{}]{
+		"lru": cache.NewLRU[string, struct{}](1000),
+		"arc": cache.NewARC[string, struct{}](1000),
+	} {
+		stats := cache.Replay(p, trace, func(string) struct{} { return struct{}{} })
+		fmt.Println(name, stats.HitRatio())
+	}
+
+The caches are not safe for concurrent use. Guard them with a sync.Mutex when shared
+between goroutines.
+*/
+package cache
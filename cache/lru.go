@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"container/list"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// LRU is a cache that evicts the least recently used entry.
+// It is the baseline policy; scans of one-off keys can flush its whole hot set.
+type LRU[K comparable, V any] struct {
+	capacity int
+	items    map[K]*list.Element
+	order    *list.List
+}
+
+// lruEntry is the payload stored in the LRU recency list.
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewLRU creates a new LRU cache holding at most capacity entries.
+// If capacity is less than 1, it is set to 1.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: normalizeCapacity(capacity),
+		items:    make(map[K]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get retrieves the value for a key and marks it as most recently used.
+func (c *LRU[K, V]) Get(key K) optional.Option[V] {
+	el, ok := c.items[key]
+	if !ok {
+		return optional.None[V]()
+	}
+	c.order.MoveToFront(el)
+	return optional.Some(el.Value.(*lruEntry[K, V]).value)
+}
+
+// Set inserts or updates a key-value pair and marks it as most recently used.
+// If the cache is full, the least recently used entry is evicted.
+func (c *LRU[K, V]) Set(key K, value V) {
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+}
+
+// Delete removes a key from the cache.
+func (c *LRU[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.order.Remove(el)
+	delete(c.items, key)
+	return true
+}
+
+// Len returns the number of cached entries.
+func (c *LRU[K, V]) Len() int {
+	return c.order.Len()
+}
+
+// Cap returns the maximum number of cached entries.
+func (c *LRU[K, V]) Cap() int {
+	return c.capacity
+}
@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"container/heap"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// LRUK is a cache implementing the LRU-K policy.
+// It evicts the entry whose K-th most recent access is the oldest.
+// Entries accessed fewer than K times are evicted first, least recently used first,
+// so a scan of one-off keys only competes with other one-off keys and leaves the hot set alone.
+//
+// Access history is only kept for cached entries.
+type LRUK[K comparable, V any] struct {
+	capacity int
+	k        int
+	clock    uint64
+	items    map[K]*lrukEntry[K, V]
+	heap     lrukHeap[K, V]
+}
+
+// lrukEntry holds a cached value and its last k access times, oldest first.
+type lrukEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	history []uint64
+	index   int
+}
+
+// NewLRUK creates a new LRU-K cache holding at most capacity entries.
+// If capacity is less than 1, it is set to 1. If k is less than 1, it is set to 2.
+// With k = 1 the policy behaves like LRU.
+func NewLRUK[K comparable, V any](capacity, k int) *LRUK[K, V] {
+	if k < 1 {
+		k = 2
+	}
+	return &LRUK[K, V]{
+		capacity: normalizeCapacity(capacity),
+		k:        k,
+		items:    make(map[K]*lrukEntry[K, V]),
+		heap:     lrukHeap[K, V]{k: k},
+	}
+}
+
+// NewLRU2 creates a new LRU-K cache with K = 2, the most common variant.
+func NewLRU2[K comparable, V any](capacity int) *LRUK[K, V] {
+	return NewLRUK[K, V](capacity, 2)
+}
+
+// Get retrieves the value for a key and records the access.
+func (c *LRUK[K, V]) Get(key K) optional.Option[V] {
+	e, ok := c.items[key]
+	if !ok {
+		return optional.None[V]()
+	}
+	c.touch(e)
+	return optional.Some(e.value)
+}
+
+// Set inserts or updates a key-value pair and records the access.
+// If the cache is full, the entry with the oldest K-th most recent access is evicted.
+func (c *LRUK[K, V]) Set(key K, value V) {
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		c.touch(e)
+		return
+	}
+
+	if len(c.items) >= c.capacity {
+		victim := heap.Pop(&c.heap).(*lrukEntry[K, V])
+		delete(c.items, victim.key)
+	}
+
+	c.clock++
+	e := &lrukEntry[K, V]{
+		key:     key,
+		value:   value,
+		history: append(make([]uint64, 0, c.k), c.clock),
+	}
+	c.items[key] = e
+	heap.Push(&c.heap, e)
+}
+
+// Delete removes a key from the cache.
+func (c *LRUK[K, V]) Delete(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	heap.Remove(&c.heap, e.index)
+	delete(c.items, key)
+	return true
+}
+
+// Len returns the number of cached entries.
+func (c *LRUK[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Cap returns the maximum number of cached entries.
+func (c *LRUK[K, V]) Cap() int {
+	return c.capacity
+}
+
+// touch records an access to an entry and restores the heap ordering.
+func (c *LRUK[K, V]) touch(e *lrukEntry[K, V]) {
+	c.clock++
+	if len(e.history) == c.k {
+		copy(e.history, e.history[1:])
+		e.history[c.k-1] = c.clock
+	} else {
+		e.history = append(e.history, c.clock)
+	}
+	heap.Fix(&c.heap, e.index)
+}
+
+// lrukHeap implements heap.Interface with the next eviction victim at the root.
+type lrukHeap[K comparable, V any] struct {
+	k       int
+	entries []*lrukEntry[K, V]
+}
+
+func (h lrukHeap[K, V]) Len() int {
+	return len(h.entries)
+}
+
+// Less orders entries with fewer than k accesses first (by last access),
+// then the remaining entries by their k-th most recent access.
+func (h lrukHeap[K, V]) Less(i, j int) bool {
+	a, b := h.entries[i], h.entries[j]
+	aFull, bFull := len(a.history) == h.k, len(b.history) == h.k
+	if aFull != bFull {
+		return !aFull
+	}
+	if !aFull {
+		return a.history[len(a.history)-1] < b.history[len(b.history)-1]
+	}
+	return a.history[0] < b.history[0]
+}
+
+func (h lrukHeap[K, V]) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.entries[i].index = i
+	h.entries[j].index = j
+}
+
+func (h *lrukHeap[K, V]) Push(x any) {
+	e := x.(*lrukEntry[K, V])
+	e.index = len(h.entries)
+	h.entries = append(h.entries, e)
+}
+
+func (h *lrukHeap[K, V]) Pop() any {
+	old := h.entries
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	h.entries = old[:n-1]
+	return e
+}
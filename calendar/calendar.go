@@ -0,0 +1,106 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/marouanesouiri/stdx/scheduler"
+	"github.com/marouanesouiri/stdx/set"
+)
+
+// Calendar decides which days are business days, from a set of weekend days and holidays.
+// A Calendar is not safe for concurrent modification; it may be read concurrently once set up.
+type Calendar struct {
+	weekend  [7]bool
+	holidays set.Set[Date]
+}
+
+// Option configures a Calendar.
+type Option func(*Calendar)
+
+// WithWeekend replaces the default weekend of Saturday and Sunday.
+func WithWeekend(days ...time.Weekday) Option {
+	return func(c *Calendar) {
+		c.weekend = [7]bool{}
+		for _, d := range days {
+			c.weekend[d] = true
+		}
+	}
+}
+
+// WithHolidays adds holidays to the Calendar.
+func WithHolidays(dates ...Date) Option {
+	return func(c *Calendar) {
+		c.holidays.AddAll(dates...)
+	}
+}
+
+// New creates a Calendar with a Saturday and Sunday weekend and no holidays, then applies opts.
+func New(opts ...Option) *Calendar {
+	c := &Calendar{holidays: set.New[Date]()}
+	c.weekend[time.Saturday] = true
+	c.weekend[time.Sunday] = true
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// AddHoliday marks d as a holiday.
+func (c *Calendar) AddHoliday(d Date) {
+	c.holidays.Add(d)
+}
+
+// Holidays returns the set of holidays. Modifying it modifies the Calendar.
+func (c *Calendar) Holidays() set.Set[Date] {
+	return c.holidays
+}
+
+// IsHoliday reports whether the date of t is a holiday.
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	return c.holidays.Contains(DateOf(t))
+}
+
+// IsBusinessDay reports whether the date of t is neither a weekend day nor a holiday.
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	return !c.weekend[t.Weekday()] && !c.IsHoliday(t)
+}
+
+// NextBusinessDay returns the same time of day on the first business day after t's date.
+// It returns t unchanged if every day of the week is a weekend day.
+func (c *Calendar) NextBusinessDay(t time.Time) time.Time {
+	return c.AddBusinessDays(t, 1)
+}
+
+// AddBusinessDays returns the same time of day n business days after t's date, or before it
+// if n is negative. Whether t itself falls on a business day does not matter.
+// It returns t unchanged if every day of the week is a weekend day.
+func (c *Calendar) AddBusinessDays(t time.Time, n int) time.Time {
+	if c.weekend == [7]bool{true, true, true, true, true, true, true} {
+		return t
+	}
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	d, offset := DateOf(t), sinceMidnight(t)
+	for n > 0 {
+		d = d.AddDays(step)
+		if !c.weekend[d.Weekday()] && !c.holidays.Contains(d) {
+			n--
+		}
+	}
+	return atTimeOfDay(d, offset, t.Location())
+}
+
+// NextWeekday returns the same time of day on the first Monday to Friday after t's date,
+// ignoring holidays. Use a Calendar to also skip holidays.
+func NextWeekday(t time.Time) time.Time {
+	return New().NextBusinessDay(t)
+}
+
+// ScheduleNextBusinessDay schedules fn on s at the given time of day, such as 9*time.Hour,
+// on the next business day after now, in now's location. It returns the scheduled task's ID.
+func (c *Calendar) ScheduleNextBusinessDay(s *scheduler.Scheduler, now time.Time, timeOfDay time.Duration, fn func()) scheduler.TaskID {
+	day := DateOf(c.NextBusinessDay(now))
+	return s.ScheduleAt(atTimeOfDay(day, timeOfDay, now.Location()), fn)
+}
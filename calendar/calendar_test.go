@@ -0,0 +1,74 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddBusinessDays(t *testing.T) {
+	christmas := NewDate(2024, time.December, 25)
+	c := New(WithHolidays(christmas))
+
+	// Tuesday 2024-12-24 at 09:30
+	eve := time.Date(2024, time.December, 24, 9, 30, 0, 0, time.UTC)
+	next := c.NextBusinessDay(eve)
+	if want := time.Date(2024, time.December, 26, 9, 30, 0, 0, time.UTC); !next.Equal(want) {
+		t.Errorf("expected %v, got %v", want, next)
+	}
+
+	// Friday 2024-12-27 plus 1 business day is Monday
+	friday := time.Date(2024, time.December, 27, 0, 0, 0, 0, time.UTC)
+	if got := c.AddBusinessDays(friday, 1); DateOf(got) != NewDate(2024, time.December, 30) {
+		t.Errorf("expected 2024-12-30, got %v", DateOf(got))
+	}
+	if got := c.AddBusinessDays(friday, -2); DateOf(got) != NewDate(2024, time.December, 24) {
+		t.Errorf("expected 2024-12-24, got %v", DateOf(got))
+	}
+
+	if !c.IsHoliday(christmas.In(time.UTC)) || c.IsBusinessDay(christmas.In(time.UTC)) {
+		t.Error("expected Christmas to be a holiday and not a business day")
+	}
+	if got := NextWeekday(friday); got.Weekday() != time.Monday {
+		t.Errorf("expected Monday, got %v", got.Weekday())
+	}
+}
+
+func TestWithinWindow(t *testing.T) {
+	businessHours := Window{
+		Days:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		Start: 9 * time.Hour,
+		End:   17 * time.Hour,
+	}
+	nightly := Window{Start: 23 * time.Hour, End: 2 * time.Hour}
+
+	monday := NewDate(2024, time.June, 3)
+	at := func(d Date, h, m int) time.Time {
+		return d.In(time.UTC).Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+	}
+
+	tests := []struct {
+		t        time.Time
+		expected bool
+	}{
+		{at(monday, 9, 0), true},
+		{at(monday, 16, 59), true},
+		{at(monday, 17, 0), false},
+		{at(monday.AddDays(5), 10, 0), false}, // Saturday
+		{at(monday, 23, 30), true},
+		{at(monday.AddDays(1), 1, 59), true},
+		{at(monday.AddDays(1), 2, 0), false},
+	}
+	for _, tt := range tests {
+		if got := WithinWindow(tt.t, businessHours, nightly); got != tt.expected {
+			t.Errorf("WithinWindow(%v): expected %v, got %v", tt.t, tt.expected, got)
+		}
+	}
+
+	friday := at(monday.AddDays(4), 18, 0)
+	if next := NextWindowStart(friday, businessHours).Get(); !next.Equal(at(monday.AddDays(7), 9, 0)) {
+		t.Errorf("expected next Monday at 09:00, got %v", next)
+	}
+	if next := NextWindowStart(friday, businessHours, nightly).Get(); !next.Equal(at(monday.AddDays(4), 23, 0)) {
+		t.Errorf("expected Friday at 23:00, got %v", next)
+	}
+}
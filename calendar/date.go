@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date is a calendar day without a time of day or location.
+// It is comparable, so it can be used as a map key or set element.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in t's location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// NewDate returns the Date for the given year, month and day, normalized like time.Date:
+// for example, October 32 becomes November 1.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// In returns midnight at the start of the date in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d; n may be negative.
+func (d Date) AddDays(n int) Date {
+	return NewDate(d.Year, d.Month, d.Day+n)
+}
+
+// Weekday returns the day of the week of d.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	return d.In(time.UTC).Before(other.In(time.UTC))
+}
+
+// String returns the date in ISO 8601 form, e.g. "2024-12-25".
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// sinceMidnight returns the time elapsed since the start of t's day, in t's location.
+func sinceMidnight(t time.Time) time.Duration {
+	return t.Sub(DateOf(t).In(t.Location()))
+}
+
+// atTimeOfDay returns the instant on date d, in loc, that is offset after midnight.
+// Across a DST change this is the wall clock time, not the elapsed duration.
+func atTimeOfDay(d Date, offset time.Duration, loc *time.Location) time.Time {
+	h := int(offset / time.Hour)
+	m := int(offset % time.Hour / time.Minute)
+	ns := int(offset % time.Minute)
+	return time.Date(d.Year, d.Month, d.Day, h, m, 0, ns, loc)
+}
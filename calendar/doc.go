@@ -0,0 +1,53 @@
+// Package calendar provides business-day arithmetic and recurring time windows for scheduling.
+//
+// # Business Days
+//
+// A Calendar knows which days are weekend days (Saturday and Sunday by default) and
+// which are holidays. Holidays are kept in a set.Set[Date]:
+//
+//	cal := calendar.New(calendar.WithHolidays(
+//	    calendar.NewDate(2024, time.December, 25),
+//	    calendar.NewDate(2025, time.January, 1),
+//	))
+//
+//	cal.IsBusinessDay(t)
+//	due := cal.AddBusinessDays(invoiceDate, 30)
+//	next := cal.NextBusinessDay(time.Now()) // same time of day
+//
+// NextWeekday skips weekends only, without a Calendar.
+//
+// # Windows
+//
+// A Window is a recurring period of the day, optionally restricted to some weekdays.
+// Windows ending before they start cross midnight:
+//
+//	businessHours := calendar.Window{
+//	    Days:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+//	    Start: 9 * time.Hour,
+//	    End:   17 * time.Hour,
+//	}
+//	maintenance := calendar.Window{Start: 23 * time.Hour, End: 2 * time.Hour}
+//
+//	if !calendar.WithinWindow(time.Now(), businessHours) {
+//	    return errOutsideBusinessHours
+//	}
+//
+// Times of day are wall clock times in the location of the time being checked.
+//
+// # Scheduler Integration
+//
+// Combine a Calendar or Windows with the scheduler package:
+//
+//	// Every business day at 09:00
+//	var run func()
+//	run = func() {
+//	    sendReport()
+//	    cal.ScheduleNextBusinessDay(s, time.Now(), 9*time.Hour, run)
+//	}
+//	cal.ScheduleNextBusinessDay(s, time.Now(), 9*time.Hour, run)
+//
+//	// At the start of the next maintenance window
+//	if start, ok := calendar.NextWindowStart(time.Now(), maintenance).Unpack(); ok {
+//	    s.ScheduleAt(start, compactDatabase)
+//	}
+package calendar
@@ -0,0 +1,76 @@
+package calendar
+
+import (
+	"slices"
+	"time"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// Window is a recurring period of the day, such as business hours or a maintenance window.
+//
+// Start and End are offsets from midnight in the location of the time being checked.
+// A window with End before or equal to Start crosses midnight: it starts on one of Days
+// and ends on the following day. An empty Days means every day.
+type Window struct {
+	Days  []time.Weekday
+	Start time.Duration
+	End   time.Duration
+}
+
+// Contains reports whether t falls inside the window. Start is inclusive and End exclusive.
+func (w Window) Contains(t time.Time) bool {
+	since := sinceMidnight(t)
+	if w.Start < w.End {
+		return w.onDay(t.Weekday()) && since >= w.Start && since < w.End
+	}
+	if since >= w.Start && w.onDay(t.Weekday()) {
+		return true
+	}
+	return since < w.End && w.onDay((t.Weekday()+6)%7)
+}
+
+// onDay reports whether the window starts on day.
+func (w Window) onDay(day time.Weekday) bool {
+	return len(w.Days) == 0 || slices.Contains(w.Days, day)
+}
+
+// next returns the first start of the window at or after t, looking at most a week ahead.
+func (w Window) next(t time.Time) optional.Option[time.Time] {
+	d := DateOf(t)
+	for i := range 8 {
+		day := d.AddDays(i)
+		if !w.onDay(day.Weekday()) {
+			continue
+		}
+		if start := atTimeOfDay(day, w.Start, t.Location()); !start.Before(t) {
+			return optional.Some(start)
+		}
+	}
+	return optional.None[time.Time]()
+}
+
+// WithinWindow reports whether t falls inside any of the windows.
+func WithinWindow(t time.Time, windows ...Window) bool {
+	for _, w := range windows {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextWindowStart returns the earliest start of any of the windows at or after t,
+// or None if no window ever starts. Pass the result to scheduler.ScheduleAt to run
+// work at the beginning of the next window.
+func NextWindowStart(t time.Time, windows ...Window) optional.Option[time.Time] {
+	result := optional.None[time.Time]()
+	for _, w := range windows {
+		if start, ok := w.next(t).Unpack(); ok {
+			if best, ok := result.Unpack(); !ok || start.Before(best) {
+				result = optional.Some(start)
+			}
+		}
+	}
+	return result
+}
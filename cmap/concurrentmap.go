@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"hash/maphash"
 	"sync"
+	"sync/atomic"
+	"unsafe"
 
 	"github.com/marouanesouiri/stdx/hash"
 	"github.com/marouanesouiri/stdx/optional"
@@ -20,6 +22,12 @@ type ConcurrentMap[K comparable, V any] struct {
 	shardMask uint32
 	hashFunc  hash.Hasher[K]
 	seed      maphash.Seed
+	sizeFunc  func(K, V) int64
+	// length is maintained on every mutation when WithLenCounter is used, nil otherwise.
+	// It is a pointer so that copies of the map share it, like the shards.
+	length *atomic.Int64
+	// listeners holds the OnSet and OnDelete callbacks; shared by copies, not by clones.
+	listeners *listeners[K, V]
 }
 
 // shard represents a single map shard with its own lock.
@@ -46,6 +54,16 @@ func WithHash[K comparable, V any](f hash.Hasher[K]) Option[K, V] {
 	}
 }
 
+// WithShardFunc replaces key hashing entirely: each key is stored in shard f(key) modulo the
+// shard count. It is meant for tests, which can force keys into the same shard to reproduce
+// collisions and hot-shard contention, or spread them over chosen shards to explore cross-shard
+// interleavings reproducibly. Production code should use WithHash instead.
+func WithShardFunc[K comparable, V any](f func(K) uint32) Option[K, V] {
+	return WithHash[K, V](func(_ maphash.Seed, key K) uint32 {
+		return f(key)
+	})
+}
+
 // WithSeed sets a specific seed for the hash function.
 func WithSeed[K comparable, V any](seed maphash.Seed) Option[K, V] {
 	return func(m ConcurrentMap[K, V]) ConcurrentMap[K, V] {
@@ -54,6 +72,26 @@ func WithSeed[K comparable, V any](seed maphash.Seed) Option[K, V] {
 	}
 }
 
+// WithSizeFunc sets a function reporting the extra heap bytes referenced by an entry,
+// such as string contents or slice backing arrays.
+// It is used by MemoryFootprint to account for variable-size keys and values.
+func WithSizeFunc[K comparable, V any](f func(K, V) int64) Option[K, V] {
+	return func(m ConcurrentMap[K, V]) ConcurrentMap[K, V] {
+		m.sizeFunc = f
+		return m
+	}
+}
+
+// WithLenCounter makes Len O(1) by maintaining an atomic entry counter, updated by every mutation.
+// It adds an atomic add to each insert and delete, and changes how Len behaves under concurrent
+// writes; see Len for the consistency model.
+func WithLenCounter[K comparable, V any]() Option[K, V] {
+	return func(m ConcurrentMap[K, V]) ConcurrentMap[K, V] {
+		m.length = new(atomic.Int64)
+		return m
+	}
+}
+
 // New creates a new ConcurrentMap with default shard count (SHARD_COUNT).
 // The shard count is optimized for typical concurrent workloads.
 func New[K comparable, V any](opts ...Option[K, V]) ConcurrentMap[K, V] {
@@ -82,6 +120,7 @@ func WithShards[K comparable, V any](shardCount int, opts ...Option[K, V]) Concu
 		shardMask: uint32(shardCount - 1),
 		hashFunc:  hash.GetHashFunc[K](),
 		seed:      maphash.MakeSeed(),
+		listeners: newListeners[K, V](),
 	}
 
 	for _, opt := range opts {
@@ -106,6 +145,15 @@ func nextPowerOf2(n int) int {
 	return n
 }
 
+// addLen adjusts the entry counter, if enabled. It must be called while holding the lock of the
+// shard that changed, so that the counter never runs ahead of or behind that shard by more
+// than the mutation in progress.
+func (m *ConcurrentMap[K, V]) addLen(delta int) {
+	if m.length != nil && delta != 0 {
+		m.length.Add(int64(delta))
+	}
+}
+
 // getShard returns the shard for the given key.
 func (m *ConcurrentMap[K, V]) getShard(key K) *shard[K, V] {
 	hashVal := m.hashFunc(m.seed, key)
@@ -117,7 +165,13 @@ func (m *ConcurrentMap[K, V]) getShard(key K) *shard[K, V] {
 func (m *ConcurrentMap[K, V]) Set(key K, value V) {
 	shard := m.getShard(key)
 	shard.mu.Lock()
+	if m.length != nil {
+		if _, ok := shard.items[key]; !ok {
+			m.addLen(1)
+		}
+	}
 	shard.items[key] = value
+	m.listeners.emit(eventSet, key, value)
 	shard.mu.Unlock()
 }
 
@@ -135,7 +189,11 @@ func (m *ConcurrentMap[K, V]) Get(key K) optional.Option[V] {
 func (m *ConcurrentMap[K, V]) Delete(key K) {
 	shard := m.getShard(key)
 	shard.mu.Lock()
-	delete(shard.items, key)
+	if old, ok := shard.items[key]; ok {
+		delete(shard.items, key)
+		m.addLen(-1)
+		m.listeners.emit(eventDelete, key, old)
+	}
 	shard.mu.Unlock()
 }
 
@@ -155,6 +213,8 @@ func (m *ConcurrentMap[K, V]) GetOrSet(key K, value V) (V, bool) {
 		return existingVal, true
 	}
 	shard.items[key] = value
+	m.addLen(1)
+	m.listeners.emit(eventSet, key, value)
 	return value, false
 }
 
@@ -169,6 +229,8 @@ func (m *ConcurrentMap[K, V]) SetIfAbsent(key K, value V) bool {
 		return false
 	}
 	shard.items[key] = value
+	m.addLen(1)
+	m.listeners.emit(eventSet, key, value)
 	return true
 }
 
@@ -182,6 +244,8 @@ func (m *ConcurrentMap[K, V]) Remove(key K) optional.Option[V] {
 	val, ok := shard.items[key]
 	if ok {
 		delete(shard.items, key)
+		m.addLen(-1)
+		m.listeners.emit(eventDelete, key, val)
 	}
 	return optional.FromPair(val, ok)
 }
@@ -197,11 +261,25 @@ func (m *ConcurrentMap[K, V]) Compute(key K, fn func(oldValue optional.Option[V]
 	oldValue, exists := shard.items[key]
 	newValue := fn(optional.FromPair(oldValue, exists))
 	shard.items[key] = newValue
+	if !exists {
+		m.addLen(1)
+	}
+	m.listeners.emit(eventSet, key, newValue)
 	return newValue
 }
 
 // Len returns the total number of items in the map.
+//
+// By default Len locks every shard in turn and sums their sizes, which is O(shards). Under concurrent
+// writes the result is not a snapshot: shards are counted at slightly different times.
+//
+// With WithLenCounter, Len is a single atomic load. The counter is updated under the same shard lock
+// as the entry it accounts for, so it is exact whenever no write is in progress and is never negative;
+// while writes are in progress it may lag behind them by the number of in-flight mutations.
 func (m *ConcurrentMap[K, V]) Len() int {
+	if m.length != nil {
+		return int(m.length.Load())
+	}
 	count := 0
 	for _, shard := range m.shards {
 		shard.mu.RLock()
@@ -215,6 +293,12 @@ func (m *ConcurrentMap[K, V]) Len() int {
 func (m *ConcurrentMap[K, V]) Clear() {
 	for _, shard := range m.shards {
 		shard.mu.Lock()
+		m.addLen(-len(shard.items))
+		if m.listeners.active.Load() {
+			for k, v := range shard.items {
+				m.listeners.emit(eventDelete, k, v)
+			}
+		}
 		shard.items = make(map[K]V)
 		shard.mu.Unlock()
 	}
@@ -247,6 +331,32 @@ func (m *ConcurrentMap[K, V]) Keys() []K {
 	return keys
 }
 
+// KeysWhere returns the keys whose entries match the predicate.
+// The predicate is evaluated under each shard's read lock, so no full snapshot of the map is made.
+func (m *ConcurrentMap[K, V]) KeysWhere(predicate func(key K, value V) bool) []K {
+	var keys []K
+	m.Range(func(key K, value V) bool {
+		if predicate(key, value) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	return keys
+}
+
+// CountWhere returns the number of entries that match the predicate.
+// The predicate is evaluated under each shard's read lock.
+func (m *ConcurrentMap[K, V]) CountWhere(predicate func(key K, value V) bool) int {
+	count := 0
+	m.Range(func(key K, value V) bool {
+		if predicate(key, value) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
 // Values returns a slice of all values in the map.
 // This creates a snapshot at the time of the call.
 func (m *ConcurrentMap[K, V]) Values() []V {
@@ -271,9 +381,14 @@ func (m *ConcurrentMap[K, V]) Items() []Item[K, V] {
 
 // Clone creates a deep copy of the ConcurrentMap with independent shards.
 // Modifications to the clone will not affect the original map and vice versa.
+// Listeners registered with OnSet and OnDelete are not copied.
 // This operation locks all shards temporarily to ensure a consistent snapshot.
 func (m *ConcurrentMap[K, V]) Clone() ConcurrentMap[K, V] {
-	clone := WithShards(len(m.shards), WithHash[K, V](m.hashFunc), WithSeed[K, V](m.seed))
+	opts := []Option[K, V]{WithHash[K, V](m.hashFunc), WithSeed[K, V](m.seed), WithSizeFunc[K, V](m.sizeFunc)}
+	if m.length != nil {
+		opts = append(opts, WithLenCounter[K, V]())
+	}
+	clone := WithShards(len(m.shards), opts...)
 	m.Range(func(key K, value V) bool {
 		clone.Set(key, value)
 		return true
@@ -281,6 +396,38 @@ func (m *ConcurrentMap[K, V]) Clone() ConcurrentMap[K, V] {
 	return clone
 }
 
+// mapHeaderSize approximates the fixed cost of an empty Go map.
+const mapHeaderSize = 48
+
+// MemoryFootprint returns an estimate of the bytes used by the map.
+// The estimate covers the shard structures, map headers, and the inline size of every key and value,
+// including the spare slots Go maps keep to stay below their maximum load factor.
+// Memory referenced by keys or values (string contents, slices, pointers) is only counted
+// when a size function is configured with WithSizeFunc, in which case every entry is visited.
+//
+// This is an estimate intended for capacity planning and quotas, not an exact measurement.
+func (m *ConcurrentMap[K, V]) MemoryFootprint() int64 {
+	var (
+		zeroKey   K
+		zeroValue V
+	)
+	slotSize := int64(unsafe.Sizeof(zeroKey)+unsafe.Sizeof(zeroValue)) + 1 // +1 control byte per slot
+
+	total := int64(unsafe.Sizeof(*m)) + int64(len(m.shards))*int64(unsafe.Sizeof(shard[K, V]{})+unsafe.Sizeof(uintptr(0))+mapHeaderSize)
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+		// Go maps stay at most 7/8 full.
+		total += int64(len(shard.items)) * slotSize * 8 / 7
+		if m.sizeFunc != nil {
+			for k, v := range shard.items {
+				total += m.sizeFunc(k, v)
+			}
+		}
+		shard.mu.RUnlock()
+	}
+	return total
+}
+
 // String returns a string representation of this cmap.
 func (m *ConcurrentMap[K, V]) String() string {
 	return fmt.Sprintf("ConcurrentMap{len=%d, shards=%d}", m.Len(), len(m.shards))
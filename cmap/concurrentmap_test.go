@@ -3,6 +3,7 @@ package cmap
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/marouanesouiri/stdx/optional"
 )
@@ -150,6 +151,15 @@ func TestConcurrentMapIteration(t *testing.T) {
 		t.Errorf("Expected 3 items, got %d", len(items))
 	}
 
+	// Test KeysWhere and CountWhere
+	odd := func(key string, value int) bool { return value%2 == 1 }
+	if keys := m.KeysWhere(odd); len(keys) != 2 {
+		t.Errorf("Expected 2 matching keys, got %d", len(keys))
+	}
+	if n := m.CountWhere(odd); n != 2 {
+		t.Errorf("Expected count 2, got %d", n)
+	}
+
 	// Test Clear
 	m.Clear()
 	if m.Len() != 0 {
@@ -157,6 +167,215 @@ func TestConcurrentMapIteration(t *testing.T) {
 	}
 }
 
+// TestConcurrentMapMemoryFootprint tests memory estimation
+func TestConcurrentMapMemoryFootprint(t *testing.T) {
+	m := New[int, int]()
+	empty := m.MemoryFootprint()
+	if empty <= 0 {
+		t.Errorf("Expected positive footprint for empty map, got %d", empty)
+	}
+
+	for i := 0; i < 1000; i++ {
+		m.Set(i, i)
+	}
+	filled := m.MemoryFootprint()
+	if filled-empty < 1000*16 {
+		t.Errorf("Expected at least 16 bytes per entry, got %d total growth", filled-empty)
+	}
+
+	sized := New(WithSizeFunc(func(k string, v []byte) int64 {
+		return int64(len(k) + cap(v))
+	}))
+	sized.Set("key", make([]byte, 1024))
+	plain := New[string, []byte]()
+	plain.Set("key", make([]byte, 1024))
+	if diff := sized.MemoryFootprint() - plain.MemoryFootprint(); diff != 1027 {
+		t.Errorf("Expected size func to add 1027 bytes, got %d", diff)
+	}
+}
+
+// TestConcurrentMapLenCounter tests Len with an atomic counter
+func TestConcurrentMapLenCounter(t *testing.T) {
+	m := New(WithLenCounter[int, int]())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := j % 500
+				switch (id + j) % 4 {
+				case 0:
+					m.Set(key, j)
+				case 1:
+					m.Delete(key)
+				case 2:
+					m.SetIfAbsent(key, j)
+				case 3:
+					m.Remove(key)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if m.Len() != len(m.Keys()) {
+		t.Errorf("Expected Len %d to match key count, got %d", len(m.Keys()), m.Len())
+	}
+
+	m.Compute(1000, func(optional.Option[int]) int { return 1 })
+	m.GetOrSet(1001, 1)
+	clone := m.Clone()
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("Expected 0 items after Clear, got %d", m.Len())
+	}
+	if clone.Len() != len(clone.Keys()) {
+		t.Errorf("Expected clone Len %d to match key count, got %d", len(clone.Keys()), clone.Len())
+	}
+}
+
+// TestConcurrentMapShardFunc tests deterministic shard assignment
+func TestConcurrentMapShardFunc(t *testing.T) {
+	spread := WithShards(4, WithShardFunc[int, int](func(k int) uint32 { return uint32(k) }))
+	for i := 0; i < 8; i++ {
+		spread.Set(i, i)
+	}
+	for i, shard := range spread.shards {
+		if len(shard.items) != 2 || shard.items[i] != i || shard.items[i+4] != i+4 {
+			t.Errorf("Expected keys %d and %d in shard %d, got %v", i, i+4, i, shard.items)
+		}
+	}
+
+	hot := New(WithShardFunc[int, int](func(int) uint32 { return 0 }))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				hot.Compute(j, func(old optional.Option[int]) int { return old.OrElse(0) + 1 })
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(hot.shards[0].items) != 100 {
+		t.Errorf("Expected all 100 keys in shard 0, got %d", len(hot.shards[0].items))
+	}
+	if v := hot.Get(42).MustGet(); v != 8 {
+		t.Errorf("Expected 8 increments, got %d", v)
+	}
+
+	clone := hot.Clone()
+	clone.Set(1000, 1)
+	if _, ok := clone.shards[0].items[1000]; !ok {
+		t.Error("Expected clone to keep the shard function")
+	}
+}
+
+// TestConcurrentMapListeners tests OnSet and OnDelete delivery and cancellation
+func TestConcurrentMapListeners(t *testing.T) {
+	m := New[string, int]()
+
+	sets := make(chan Item[string, int], 16)
+	deletes := make(chan Item[string, int], 16)
+	cancelSet := m.OnSet(func(k string, v int) { sets <- Item[string, int]{k, v} })
+	cancelDelete := m.OnDelete(func(k string, v int) { deletes <- Item[string, int]{k, v} })
+
+	m.Set("a", 1)
+	m.Set("a", 2)
+	m.SetIfAbsent("a", 3) // no change
+	m.GetOrSet("b", 4)
+	m.Compute("b", func(old optional.Option[int]) int { return old.OrElse(0) + 1 })
+	m.Delete("missing")
+	m.Delete("a")
+	m.Remove("b")
+
+	wantSets := []Item[string, int]{{"a", 1}, {"a", 2}, {"b", 4}, {"b", 5}}
+	for _, want := range wantSets {
+		if got := <-sets; got != want {
+			t.Errorf("Expected set %v, got %v", want, got)
+		}
+	}
+	for _, want := range []Item[string, int]{{"a", 2}, {"b", 5}} {
+		if got := <-deletes; got != want {
+			t.Errorf("Expected delete %v, got %v", want, got)
+		}
+	}
+
+	m.Set("c", 6)
+	<-sets
+	m.Clear()
+	if got := <-deletes; got != (Item[string, int]{"c", 6}) {
+		t.Errorf("Expected Clear to deliver delete of c, got %v", got)
+	}
+
+	clone := m.Clone()
+	clone.Set("clone", 1)
+	m.Set("marker", 8)
+	if got := <-sets; got != (Item[string, int]{"marker", 8}) {
+		t.Errorf("Expected the clone not to notify the original's listeners, got %v", got)
+	}
+
+	cancelSet()
+	cancelDelete()
+	m.Set("after", 7)
+	select {
+	case got := <-sets:
+		t.Errorf("Expected no event after cancel, got %v", got)
+	case got := <-deletes:
+		t.Errorf("Expected no event after cancel, got %v", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+// TestConcurrentMapListenersStaleEvent tests that an event queued after delivery stopped is not
+// delivered to a listener registered later
+func TestConcurrentMapListenersStaleEvent(t *testing.T) {
+	m := New[string, int]()
+	m.OnSet(func(string, int) {})()
+	for {
+		m.listeners.mu.Lock()
+		running := m.listeners.running
+		m.listeners.mu.Unlock()
+		if !running {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// Simulate a writer that checked active just before the listener was cancelled.
+	m.listeners.queue.Push(event[string, int]{kind: eventSet, key: "stale", value: 1})
+
+	sets := make(chan Item[string, int], 4)
+	defer m.OnSet(func(k string, v int) { sets <- Item[string, int]{k, v} })()
+	m.Set("fresh", 2)
+	if got := <-sets; got != (Item[string, int]{"fresh", 2}) {
+		t.Errorf("Expected only the fresh event, got %v", got)
+	}
+}
+
+// BenchmarkConcurrentMapLen benchmarks Len with and without the counter
+func BenchmarkConcurrentMapLen(b *testing.B) {
+	for _, tt := range []struct {
+		name string
+		m    ConcurrentMap[int, int]
+	}{
+		{"Locked", New[int, int]()},
+		{"Counter", New(WithLenCounter[int, int]())},
+	} {
+		for i := 0; i < 10000; i++ {
+			tt.m.Set(i, i)
+		}
+		b.Run(tt.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tt.m.Len()
+			}
+		})
+	}
+}
+
 // BenchmarkConcurrentMapSet benchmarks Set operations
 func BenchmarkConcurrentMapSet(b *testing.B) {
 	m := New[int, int]()
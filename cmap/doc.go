@@ -62,6 +62,17 @@
 //
 // Note: Shard count is automatically rounded up to the next power of 2.
 //
+// # Deterministic Sharding
+//
+// WithShardFunc decides the shard of every key without hashing, so tests can put keys
+// in the same shard or in different shards on purpose:
+//
+//	// Every key in shard 0: worst-case contention
+//	hot := cmap.New(cmap.WithShardFunc[string, int](func(string) uint32 { return 0 }))
+//
+//	// Key i in shard i % 4
+//	spread := cmap.WithShards(4, cmap.WithShardFunc[int, int](func(k int) uint32 { return uint32(k) }))
+//
 // # Atomic Operations
 //
 // Perform atomic operations without race conditions:
@@ -147,6 +158,11 @@
 //	    fmt.Printf("%s: %d\n", item.Key, item.Value)
 //	}
 //
+// Filter without copying the whole map first:
+//
+//	big := m.KeysWhere(func(k string, v int) bool { return v > 1 })  // []string{"b", "c"}
+//	n := m.CountWhere(func(k string, v int) bool { return v%2 == 1 }) // 2
+//
 // # Common Patterns
 //
 // **Concurrent counter:**
@@ -253,7 +269,7 @@
 //   - Get: O(1) average, with shard lock overhead
 //   - Set: O(1) average, with shard lock overhead
 //   - Delete: O(1) average, with shard lock overhead
-//   - Len: O(shards) - requires locking all shards
+//   - Len: O(shards) - requires locking all shards, O(1) with WithLenCounter
 //   - Range: O(n) where n is total items
 //
 // **Space Complexity:**
@@ -339,6 +355,37 @@
 //	    return old + 1
 //	})
 //
+// # Len Consistency
+//
+// By default Len locks each shard in turn and adds up their sizes. For maps where Len is
+// called often, such as size-based admission or metrics scraping, WithLenCounter keeps an
+// atomic counter updated by every insert and delete, making Len O(1):
+//
+//	m := cmap.New(cmap.WithLenCounter[string, int]())
+//
+// The two modes differ only while writes are in flight:
+//   - Without the counter, shards are counted one after another, so the total may mix
+//     states from slightly different times.
+//   - With the counter, each update happens under the same shard lock as the change it
+//     accounts for. Len is exact whenever no write is in progress, never negative, and
+//     otherwise lags by at most the number of in-flight mutations.
+//
+// # Change Listeners
+//
+// OnSet and OnDelete register callbacks for inserts, updates and deletions, e.g. to keep a
+// secondary index or cache in sync. Both return a function that removes the listener:
+//
+//	cancel := m.OnDelete(func(key string, value int) {
+//	    index.Remove(key)
+//	})
+//	defer cancel()
+//
+// Listeners are called asynchronously on a single background goroutine fed by an internal
+// queue, so writers never wait for them. Changes to the same key are delivered in the order
+// they were applied, but a listener may run after later changes are already visible in the
+// map. The goroutine is only started while listeners are registered, and a map without
+// listeners pays a single atomic load per mutation.
+//
 // # Memory Considerations
 //
 // Each shard maintains its own map, so memory overhead includes:
@@ -348,6 +395,14 @@
 //
 // This is negligible for most applications.
 //
+// Use MemoryFootprint to estimate the total size of a map, e.g. for capacity planning
+// or per-tenant quotas. Configure WithSizeFunc to include memory referenced by entries:
+//
+//	m := cmap.New(cmap.WithSizeFunc(func(k string, v []byte) int64 {
+//	    return int64(len(k) + cap(v))
+//	}))
+//	fmt.Println(m.MemoryFootprint(), "bytes (estimated)")
+//
 // # Thread Safety Guarantees
 //
 // All methods are thread-safe and can be called concurrently:
@@ -360,6 +415,6 @@
 //
 // - Keys must be comparable (same as Go maps)
 // - Range operations snapshot per-shard, not whole map atomically
-// - Len() requires locking all shards (relatively expensive)
+// - Len() requires locking all shards (relatively expensive) unless WithLenCounter is used
 // - Not ordered (iteration order is non-deterministic)
 package cmap
@@ -0,0 +1,151 @@
+package cmap
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/marouanesouiri/stdx/lockfree"
+)
+
+type eventKind uint8
+
+const (
+	eventSet eventKind = iota
+	eventDelete
+)
+
+// event is a mutation waiting to be delivered to listeners.
+type event[K comparable, V any] struct {
+	kind  eventKind
+	key   K
+	value V
+}
+
+// listeners delivers mutation events to registered callbacks on a background goroutine.
+//
+// Events are queued while the shard lock is held, so events for the same key are delivered
+// in the order the mutations were applied. The queue is unbounded and never blocks writers.
+// The delivery goroutine only runs while at least one listener is registered.
+type listeners[K comparable, V any] struct {
+	// active is true while at least one listener is registered; writers check it before queuing.
+	active atomic.Bool
+	queue  *lockfree.MPSCQueue[event[K, V]]
+	signal chan struct{}
+
+	mu       sync.Mutex
+	onSet    map[uint64]func(K, V)
+	onDelete map[uint64]func(K, V)
+	nextID   uint64
+	running  bool
+}
+
+func newListeners[K comparable, V any]() *listeners[K, V] {
+	return &listeners[K, V]{
+		queue:    lockfree.New[event[K, V]](),
+		signal:   make(chan struct{}, 1),
+		onSet:    make(map[uint64]func(K, V)),
+		onDelete: make(map[uint64]func(K, V)),
+	}
+}
+
+// emit queues an event if any listener is registered.
+func (l *listeners[K, V]) emit(kind eventKind, key K, value V) {
+	if !l.active.Load() {
+		return
+	}
+	l.queue.Push(event[K, V]{kind: kind, key: key, value: value})
+	select {
+	case l.signal <- struct{}{}:
+	default:
+	}
+}
+
+// add registers fn in target and returns the function removing it.
+func (l *listeners[K, V]) add(target map[uint64]func(K, V), fn func(K, V)) (cancel func()) {
+	l.mu.Lock()
+	l.nextID++
+	id := l.nextID
+	target[id] = fn
+	if !l.running {
+		// A writer that saw active before the last listener was removed may have queued an
+		// event after the delivery goroutine stopped; drop it instead of delivering it here.
+		l.queue.Drain(func(event[K, V]) {})
+		l.running = true
+		go l.run()
+	}
+	l.active.Store(true)
+	l.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			delete(target, id)
+			if len(l.onSet) == 0 && len(l.onDelete) == 0 {
+				l.active.Store(false)
+				l.wake()
+			}
+			l.mu.Unlock()
+		})
+	}
+}
+
+func (l *listeners[K, V]) wake() {
+	select {
+	case l.signal <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers queued events until no listener is left.
+func (l *listeners[K, V]) run() {
+	for range l.signal {
+		l.mu.Lock()
+		onSet := make([]func(K, V), 0, len(l.onSet))
+		for _, fn := range l.onSet {
+			onSet = append(onSet, fn)
+		}
+		onDelete := make([]func(K, V), 0, len(l.onDelete))
+		for _, fn := range l.onDelete {
+			onDelete = append(onDelete, fn)
+		}
+		l.mu.Unlock()
+
+		l.queue.Drain(func(ev event[K, V]) {
+			fns := onSet
+			if ev.kind == eventDelete {
+				fns = onDelete
+			}
+			for _, fn := range fns {
+				fn(ev.key, ev.value)
+			}
+		})
+
+		l.mu.Lock()
+		if !l.active.Load() && l.queue.Empty() {
+			l.running = false
+			l.mu.Unlock()
+			return
+		}
+		l.mu.Unlock()
+	}
+}
+
+// OnSet registers fn to be called with the key and new value after every insert or update,
+// including those made by GetOrSet, SetIfAbsent and Compute. It returns a function that
+// removes the listener.
+//
+// Listeners run asynchronously on a single background goroutine, in the order the changes
+// were made to each key, so they never slow down writers. Because of this, a listener may
+// run after later changes to the map are already visible. Listeners must not block for long,
+// as they delay the delivery of subsequent events.
+func (m *ConcurrentMap[K, V]) OnSet(fn func(key K, value V)) (cancel func()) {
+	return m.listeners.add(m.listeners.onSet, fn)
+}
+
+// OnDelete registers fn to be called with the key and removed value after every deletion,
+// including those made by Remove and Clear. It returns a function that removes the listener.
+// Listeners are delivered like OnSet listeners.
+func (m *ConcurrentMap[K, V]) OnDelete(fn func(key K, value V)) (cancel func()) {
+	return m.listeners.add(m.listeners.onDelete, fn)
+}
@@ -1,8 +1,18 @@
 package collectors
 
 import (
+	"fmt"
+	"iter"
+	"math"
+	"math/big"
+	"slices"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/marouanesouiri/stdx/cmap"
+	"github.com/marouanesouiri/stdx/mmap"
+	"github.com/marouanesouiri/stdx/omap"
 	"github.com/marouanesouiri/stdx/optional"
 	"github.com/marouanesouiri/stdx/set"
 )
@@ -15,6 +25,20 @@ type Collector[T, A, R any] interface {
 	Finisher(acc A) R
 }
 
+// ShortCircuiting is implemented by collectors whose result can be known before every element
+// has been seen, such as First or AnyMatch. Done reports whether accumulating more elements
+// could still change the result; once it returns true, stream.CollectTo stops consuming the stream.
+// Implementing it is optional: collectors without it always consume the whole stream.
+type ShortCircuiting[A any] interface {
+	Done(acc A) bool
+}
+
+// isDone reports whether c is a ShortCircuiting collector that is done with acc.
+func isDone[A any](c any, acc A) bool {
+	sc, ok := c.(ShortCircuiting[A])
+	return ok && sc.Done(acc)
+}
+
 type sliceCollector[T any] struct{}
 
 func (c sliceCollector[T]) Supplier() []T {
@@ -29,6 +53,10 @@ func (c sliceCollector[T]) Finisher(acc []T) []T {
 	return acc
 }
 
+func (c sliceCollector[T]) Combine(a1, a2 []T) []T {
+	return append(a1, a2...)
+}
+
 // ToSlice returns a Collector that accumulates elements into a slice.
 func ToSlice[T any]() Collector[T, []T, []T] {
 	return sliceCollector[T]{}
@@ -49,6 +77,14 @@ func (c setCollector[T]) Finisher(acc set.Set[T]) set.Set[T] {
 	return acc
 }
 
+func (c setCollector[T]) Combine(a1, a2 set.Set[T]) set.Set[T] {
+	a2.Range(func(item T) bool {
+		a1.Add(item)
+		return true
+	})
+	return a1
+}
+
 // ToSet returns a Collector that accumulates elements into a Set.
 func ToSet[T comparable]() Collector[T, set.Set[T], set.Set[T]] {
 	return setCollector[T]{}
@@ -83,6 +119,17 @@ func (c joiningCollector) Finisher(acc *strings.Builder) string {
 	return result.String()
 }
 
+func (c joiningCollector) Combine(a1, a2 *strings.Builder) *strings.Builder {
+	if a2.Len() == 0 {
+		return a1
+	}
+	if a1.Len() > 0 {
+		a1.WriteString(c.separator)
+	}
+	a1.WriteString(a2.String())
+	return a1
+}
+
 // Joining returns a Collector that concatenates strings with a separator.
 func Joining(separator string) Collector[string, *strings.Builder, string] {
 	return joiningCollector{separator: separator}
@@ -93,6 +140,107 @@ func JoiningWith(separator, prefix, suffix string) Collector[string, *strings.Bu
 	return joiningCollector{separator: separator, prefix: prefix, suffix: suffix}
 }
 
+// JoiningOption configures JoiningBy and JoiningStringer.
+type JoiningOption func(*joiningConfig)
+
+type joiningConfig struct {
+	maxLen int
+}
+
+// WithMaxLength truncates the joined string to at most n bytes, cut on a rune boundary and
+// followed by "..." when anything was dropped. Once the limit is reached, no more elements are
+// converted and the collector short-circuits, which keeps log lines about huge streams cheap.
+// A non-positive n disables truncation.
+func WithMaxLength(n int) JoiningOption {
+	return func(c *joiningConfig) {
+		c.maxLen = n
+	}
+}
+
+type joinState struct {
+	b         strings.Builder
+	count     int
+	truncated bool
+}
+
+type joiningByCollector[T any] struct {
+	separator string
+	toString  func(T) string
+	maxLen    int
+}
+
+func (c joiningByCollector[T]) Supplier() *joinState {
+	return &joinState{}
+}
+
+func (c joiningByCollector[T]) Accumulator(acc *joinState, elem T) *joinState {
+	if acc.truncated {
+		return acc
+	}
+	if acc.count > 0 {
+		acc.b.WriteString(c.separator)
+	}
+	acc.b.WriteString(c.toString(elem))
+	acc.count++
+	c.truncate(acc)
+	return acc
+}
+
+func (c joiningByCollector[T]) Finisher(acc *joinState) string {
+	if acc.truncated {
+		return acc.b.String() + "..."
+	}
+	return acc.b.String()
+}
+
+func (c joiningByCollector[T]) Combine(a1, a2 *joinState) *joinState {
+	if a2.count == 0 || a1.truncated {
+		return a1
+	}
+	if a1.count > 0 {
+		a1.b.WriteString(c.separator)
+	}
+	a1.b.WriteString(a2.b.String())
+	a1.count += a2.count
+	a1.truncated = a2.truncated
+	c.truncate(a1)
+	return a1
+}
+
+func (c joiningByCollector[T]) Done(acc *joinState) bool {
+	return acc.truncated
+}
+
+// truncate cuts acc to maxLen bytes if it is longer, without splitting a rune.
+func (c joiningByCollector[T]) truncate(acc *joinState) {
+	if c.maxLen <= 0 || acc.b.Len() <= c.maxLen {
+		return
+	}
+	s := acc.b.String()
+	end := c.maxLen
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	acc.b.Reset()
+	acc.b.WriteString(s[:end])
+	acc.truncated = true
+}
+
+// JoiningBy returns a Collector that converts each element to a string with toString and
+// concatenates the strings with a separator, without a separate mapping step.
+func JoiningBy[T any](separator string, toString func(T) string, opts ...JoiningOption) Collector[T, *joinState, string] {
+	var cfg joiningConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return joiningByCollector[T]{separator: separator, toString: toString, maxLen: cfg.maxLen}
+}
+
+// JoiningStringer returns a Collector that concatenates the String() of each element with a separator.
+func JoiningStringer[T fmt.Stringer](separator string, opts ...JoiningOption) Collector[T, *joinState, string] {
+	return JoiningBy(separator, func(v T) string { return v.String() }, opts...)
+}
+
 type countingCollector[T any] struct{}
 
 func (c countingCollector[T]) Supplier() int64 {
@@ -107,6 +255,10 @@ func (c countingCollector[T]) Finisher(acc int64) int64 {
 	return acc
 }
 
+func (c countingCollector[T]) Combine(a1, a2 int64) int64 {
+	return a1 + a2
+}
+
 // Counting returns a Collector that counts the number of elements.
 func Counting[T any]() Collector[T, int64, int64] {
 	return countingCollector[T]{}
@@ -134,6 +286,10 @@ func (c summingCollector[T, N]) Finisher(acc N) N {
 	return acc
 }
 
+func (c summingCollector[T, N]) Combine(a1, a2 N) N {
+	return a1 + a2
+}
+
 // Summing returns a Collector that sums numeric values extracted by the mapper.
 func Summing[T any, N Number](mapper func(T) N) Collector[T, N, N] {
 	return summingCollector[T, N]{mapper: mapper}
@@ -165,11 +321,97 @@ func (c averagingCollector[T]) Finisher(acc avgState) float64 {
 	return acc.sum / float64(acc.count)
 }
 
+func (c averagingCollector[T]) Combine(a1, a2 avgState) avgState {
+	return avgState{sum: a1.sum + a2.sum, count: a1.count + a2.count}
+}
+
 // Averaging returns a Collector that computes the average of numeric values.
 func Averaging[T any](mapper func(T) float64) Collector[T, avgState, float64] {
 	return averagingCollector[T]{mapper: mapper}
 }
 
+type summingBigCollector[T any] struct {
+	mapper func(T) int64
+}
+
+func (c summingBigCollector[T]) Supplier() *big.Int {
+	return new(big.Int)
+}
+
+func (c summingBigCollector[T]) Accumulator(acc *big.Int, elem T) *big.Int {
+	return acc.Add(acc, big.NewInt(c.mapper(elem)))
+}
+
+func (c summingBigCollector[T]) Finisher(acc *big.Int) *big.Int {
+	return acc
+}
+
+func (c summingBigCollector[T]) Combine(a1, a2 *big.Int) *big.Int {
+	return a1.Add(a1, a2)
+}
+
+// SummingBig returns a Collector that sums int64 values extracted by the mapper into a big.Int,
+// so the sum cannot overflow however many values are aggregated.
+func SummingBig[T any](mapper func(T) int64) Collector[T, *big.Int, *big.Int] {
+	return summingBigCollector[T]{mapper: mapper}
+}
+
+type preciseAvgState struct {
+	sum          float64
+	compensation float64
+	count        int64
+}
+
+type averagingPreciseCollector[T any] struct {
+	mapper func(T) float64
+}
+
+func (c averagingPreciseCollector[T]) Supplier() preciseAvgState {
+	return preciseAvgState{}
+}
+
+// Accumulator adds the value using Neumaier's variant of Kahan summation, which tracks
+// the low-order bits lost by each addition in a separate compensation term.
+func (c averagingPreciseCollector[T]) Accumulator(acc preciseAvgState, elem T) preciseAvgState {
+	value := c.mapper(elem)
+	sum := acc.sum + value
+	if math.Abs(acc.sum) >= math.Abs(value) {
+		acc.compensation += (acc.sum - sum) + value
+	} else {
+		acc.compensation += (value - sum) + acc.sum
+	}
+	acc.sum = sum
+	acc.count++
+	return acc
+}
+
+func (c averagingPreciseCollector[T]) Finisher(acc preciseAvgState) float64 {
+	if acc.count == 0 {
+		return 0
+	}
+	return (acc.sum + acc.compensation) / float64(acc.count)
+}
+
+func (c averagingPreciseCollector[T]) Combine(a1, a2 preciseAvgState) preciseAvgState {
+	sum := a1.sum + a2.sum
+	if math.Abs(a1.sum) >= math.Abs(a2.sum) {
+		a1.compensation += (a1.sum - sum) + a2.sum
+	} else {
+		a1.compensation += (a2.sum - sum) + a1.sum
+	}
+	a1.sum = sum
+	a1.compensation += a2.compensation
+	a1.count += a2.count
+	return a1
+}
+
+// AveragingPrecise returns a Collector that computes the average of numeric values like Averaging,
+// but uses compensated (Kahan) summation to avoid the precision loss of adding many values,
+// or values of very different magnitudes, to a plain float64.
+func AveragingPrecise[T any](mapper func(T) float64) Collector[T, preciseAvgState, float64] {
+	return averagingPreciseCollector[T]{mapper: mapper}
+}
+
 type minByCollector[T any] struct {
 	less func(T, T) bool
 }
@@ -192,6 +434,13 @@ func (c minByCollector[T]) Finisher(acc optional.Option[T]) optional.Option[T] {
 	return acc
 }
 
+func (c minByCollector[T]) Combine(a1, a2 optional.Option[T]) optional.Option[T] {
+	if a2.IsAbsent() {
+		return a1
+	}
+	return c.Accumulator(a1, a2.Get())
+}
+
 // MinBy returns a Collector that finds the minimum element according to the less function.
 func MinBy[T any](less func(T, T) bool) Collector[T, optional.Option[T], optional.Option[T]] {
 	return minByCollector[T]{less: less}
@@ -219,11 +468,108 @@ func (c maxByCollector[T]) Finisher(acc optional.Option[T]) optional.Option[T] {
 	return acc
 }
 
+func (c maxByCollector[T]) Combine(a1, a2 optional.Option[T]) optional.Option[T] {
+	if a2.IsAbsent() {
+		return a1
+	}
+	return c.Accumulator(a1, a2.Get())
+}
+
 // MaxBy returns a Collector that finds the maximum element according to the less function.
 func MaxBy[T any](less func(T, T) bool) Collector[T, optional.Option[T], optional.Option[T]] {
 	return maxByCollector[T]{less: less}
 }
 
+type firstCollector[T any] struct{}
+
+func (c firstCollector[T]) Supplier() optional.Option[T] {
+	return optional.None[T]()
+}
+
+func (c firstCollector[T]) Accumulator(acc optional.Option[T], elem T) optional.Option[T] {
+	if acc.IsAbsent() {
+		return optional.Some(elem)
+	}
+	return acc
+}
+
+func (c firstCollector[T]) Finisher(acc optional.Option[T]) optional.Option[T] {
+	return acc
+}
+
+func (c firstCollector[T]) Combine(a1, a2 optional.Option[T]) optional.Option[T] {
+	return a1.Or(a2)
+}
+
+func (c firstCollector[T]) Done(acc optional.Option[T]) bool {
+	return acc.IsPresent()
+}
+
+// First returns a short-circuiting Collector that finds the first element, if any.
+func First[T any]() Collector[T, optional.Option[T], optional.Option[T]] {
+	return firstCollector[T]{}
+}
+
+type anyMatchCollector[T any] struct {
+	predicate func(T) bool
+}
+
+func (c anyMatchCollector[T]) Supplier() bool {
+	return false
+}
+
+func (c anyMatchCollector[T]) Accumulator(acc bool, elem T) bool {
+	return acc || c.predicate(elem)
+}
+
+func (c anyMatchCollector[T]) Finisher(acc bool) bool {
+	return acc
+}
+
+func (c anyMatchCollector[T]) Combine(a1, a2 bool) bool {
+	return a1 || a2
+}
+
+func (c anyMatchCollector[T]) Done(acc bool) bool {
+	return acc
+}
+
+// AnyMatch returns a short-circuiting Collector that reports whether any element matches the predicate.
+// It returns false for an empty stream.
+func AnyMatch[T any](predicate func(T) bool) Collector[T, bool, bool] {
+	return anyMatchCollector[T]{predicate: predicate}
+}
+
+type allMatchCollector[T any] struct {
+	predicate func(T) bool
+}
+
+func (c allMatchCollector[T]) Supplier() bool {
+	return true
+}
+
+func (c allMatchCollector[T]) Accumulator(acc bool, elem T) bool {
+	return acc && c.predicate(elem)
+}
+
+func (c allMatchCollector[T]) Finisher(acc bool) bool {
+	return acc
+}
+
+func (c allMatchCollector[T]) Combine(a1, a2 bool) bool {
+	return a1 && a2
+}
+
+func (c allMatchCollector[T]) Done(acc bool) bool {
+	return !acc
+}
+
+// AllMatch returns a short-circuiting Collector that reports whether every element matches the predicate.
+// It returns true for an empty stream.
+func AllMatch[T any](predicate func(T) bool) Collector[T, bool, bool] {
+	return allMatchCollector[T]{predicate: predicate}
+}
+
 type groupingByCollector[T any, K comparable] struct {
 	keyFn func(T) K
 }
@@ -242,11 +588,221 @@ func (c groupingByCollector[T, K]) Finisher(acc map[K][]T) map[K][]T {
 	return acc
 }
 
+func (c groupingByCollector[T, K]) Combine(a1, a2 map[K][]T) map[K][]T {
+	for key, values := range a2 {
+		a1[key] = append(a1[key], values...)
+	}
+	return a1
+}
+
 // GroupingBy returns a Collector that groups elements by a key function.
 func GroupingBy[T any, K comparable](keyFn func(T) K) Collector[T, map[K][]T, map[K][]T] {
 	return groupingByCollector[T, K]{keyFn: keyFn}
 }
 
+type groupingByWithCollector[T any, K comparable, A, R any] struct {
+	keyFn      func(T) K
+	downstream Collector[T, A, R]
+}
+
+func (c groupingByWithCollector[T, K, A, R]) Supplier() map[K]A {
+	return make(map[K]A)
+}
+
+func (c groupingByWithCollector[T, K, A, R]) Accumulator(acc map[K]A, elem T) map[K]A {
+	key := c.keyFn(elem)
+	groupAcc, exists := acc[key]
+	if !exists {
+		groupAcc = c.downstream.Supplier()
+	}
+	acc[key] = c.downstream.Accumulator(groupAcc, elem)
+	return acc
+}
+
+func (c groupingByWithCollector[T, K, A, R]) Finisher(acc map[K]A) map[K]R {
+	result := make(map[K]R, len(acc))
+	for key, groupAcc := range acc {
+		result[key] = c.downstream.Finisher(groupAcc)
+	}
+	return result
+}
+
+func (c groupingByWithCollector[T, K, A, R]) Combine(a1, a2 map[K]A) map[K]A {
+	return combineGroups(c.downstream, a1, a2)
+}
+
+func (c groupingByWithCollector[T, K, A, R]) canCombine() bool {
+	return canCombine(c.downstream)
+}
+
+// GroupingByWith returns a Collector that groups elements by a key function and reduces
+// each group with the downstream collector, in a single pass.
+// Unlike GroupingBy, no slice of elements is built per key: each group only holds
+// the downstream accumulator, such as a count or a running sum.
+func GroupingByWith[T any, K comparable, A, R any](keyFn func(T) K, downstream Collector[T, A, R]) Collector[T, map[K]A, map[K]R] {
+	return groupingByWithCollector[T, K, A, R]{keyFn: keyFn, downstream: downstream}
+}
+
+type mappingCollector[T, U, A, R any] struct {
+	mapper     func(T) U
+	downstream Collector[U, A, R]
+}
+
+func (c mappingCollector[T, U, A, R]) Supplier() A {
+	return c.downstream.Supplier()
+}
+
+func (c mappingCollector[T, U, A, R]) Accumulator(acc A, elem T) A {
+	return c.downstream.Accumulator(acc, c.mapper(elem))
+}
+
+func (c mappingCollector[T, U, A, R]) Finisher(acc A) R {
+	return c.downstream.Finisher(acc)
+}
+
+func (c mappingCollector[T, U, A, R]) Combine(a1, a2 A) A {
+	return combine(c.downstream, a1, a2)
+}
+
+func (c mappingCollector[T, U, A, R]) canCombine() bool {
+	return canCombine(c.downstream)
+}
+
+func (c mappingCollector[T, U, A, R]) Done(acc A) bool {
+	return isDone(c.downstream, acc)
+}
+
+// Mapping adapts a downstream collector to accept elements of another type by transforming
+// each element with mapper before accumulating it. It is mostly useful as the downstream of
+// GroupingByWith, e.g. to collect only the names of each group.
+func Mapping[T, U, A, R any](mapper func(T) U, downstream Collector[U, A, R]) Collector[T, A, R] {
+	return mappingCollector[T, U, A, R]{mapper: mapper, downstream: downstream}
+}
+
+type filteringCollector[T, A, R any] struct {
+	predicate  func(T) bool
+	downstream Collector[T, A, R]
+}
+
+func (c filteringCollector[T, A, R]) Supplier() A {
+	return c.downstream.Supplier()
+}
+
+func (c filteringCollector[T, A, R]) Accumulator(acc A, elem T) A {
+	if c.predicate(elem) {
+		return c.downstream.Accumulator(acc, elem)
+	}
+	return acc
+}
+
+func (c filteringCollector[T, A, R]) Finisher(acc A) R {
+	return c.downstream.Finisher(acc)
+}
+
+func (c filteringCollector[T, A, R]) Combine(a1, a2 A) A {
+	return combine(c.downstream, a1, a2)
+}
+
+func (c filteringCollector[T, A, R]) canCombine() bool {
+	return canCombine(c.downstream)
+}
+
+func (c filteringCollector[T, A, R]) Done(acc A) bool {
+	return isDone(c.downstream, acc)
+}
+
+// Filtering adapts a downstream collector to only accumulate the elements matching the predicate.
+// Used as the downstream of GroupingByWith, it differs from filtering the stream first:
+// a group whose elements are all rejected is still present in the result, with an empty value.
+func Filtering[T, A, R any](predicate func(T) bool, downstream Collector[T, A, R]) Collector[T, A, R] {
+	return filteringCollector[T, A, R]{predicate: predicate, downstream: downstream}
+}
+
+type flatMappingCollector[T, U, A, R any] struct {
+	mapper     func(T) iter.Seq[U]
+	downstream Collector[U, A, R]
+}
+
+func (c flatMappingCollector[T, U, A, R]) Supplier() A {
+	return c.downstream.Supplier()
+}
+
+func (c flatMappingCollector[T, U, A, R]) Accumulator(acc A, elem T) A {
+	for u := range c.mapper(elem) {
+		acc = c.downstream.Accumulator(acc, u)
+		if isDone(c.downstream, acc) {
+			break
+		}
+	}
+	return acc
+}
+
+func (c flatMappingCollector[T, U, A, R]) Finisher(acc A) R {
+	return c.downstream.Finisher(acc)
+}
+
+func (c flatMappingCollector[T, U, A, R]) Combine(a1, a2 A) A {
+	return combine(c.downstream, a1, a2)
+}
+
+func (c flatMappingCollector[T, U, A, R]) canCombine() bool {
+	return canCombine(c.downstream)
+}
+
+func (c flatMappingCollector[T, U, A, R]) Done(acc A) bool {
+	return isDone(c.downstream, acc)
+}
+
+// FlatMapping adapts a downstream collector by accumulating every value of the sequence
+// returned by mapper for each element. Use slices.Values to flatten slices.
+func FlatMapping[T, U, A, R any](mapper func(T) iter.Seq[U], downstream Collector[U, A, R]) Collector[T, A, R] {
+	return flatMappingCollector[T, U, A, R]{mapper: mapper, downstream: downstream}
+}
+
+type bucketingByCollector[T, A, R any] struct {
+	timeFn     func(T) time.Time
+	bucketSize time.Duration
+	downstream Collector[T, A, R]
+}
+
+func (c bucketingByCollector[T, A, R]) Supplier() map[time.Time]A {
+	return make(map[time.Time]A)
+}
+
+func (c bucketingByCollector[T, A, R]) Accumulator(acc map[time.Time]A, elem T) map[time.Time]A {
+	bucket := c.timeFn(elem).Truncate(c.bucketSize)
+	bucketAcc, exists := acc[bucket]
+	if !exists {
+		bucketAcc = c.downstream.Supplier()
+	}
+	acc[bucket] = c.downstream.Accumulator(bucketAcc, elem)
+	return acc
+}
+
+func (c bucketingByCollector[T, A, R]) Finisher(acc map[time.Time]A) map[time.Time]R {
+	result := make(map[time.Time]R, len(acc))
+	for bucket, bucketAcc := range acc {
+		result[bucket] = c.downstream.Finisher(bucketAcc)
+	}
+	return result
+}
+
+func (c bucketingByCollector[T, A, R]) Combine(a1, a2 map[time.Time]A) map[time.Time]A {
+	return combineGroups(c.downstream, a1, a2)
+}
+
+func (c bucketingByCollector[T, A, R]) canCombine() bool {
+	return canCombine(c.downstream)
+}
+
+// BucketingBy returns a Collector that groups elements into fixed-size time buckets
+// and reduces each bucket with the downstream collector, in a single pass.
+// The bucket key is the element time truncated to a multiple of bucketSize (see time.Time.Truncate).
+// For example, a bucketSize of time.Minute produces per-minute results.
+func BucketingBy[T, A, R any](timeFn func(T) time.Time, bucketSize time.Duration, downstream Collector[T, A, R]) Collector[T, map[time.Time]A, map[time.Time]R] {
+	return bucketingByCollector[T, A, R]{timeFn: timeFn, bucketSize: bucketSize, downstream: downstream}
+}
+
 type partitionState[T any] struct {
 	trueList  []T
 	falseList []T
@@ -279,6 +835,12 @@ func (c partitioningByCollector[T]) Finisher(acc partitionState[T]) map[bool][]T
 	}
 }
 
+func (c partitioningByCollector[T]) Combine(a1, a2 partitionState[T]) partitionState[T] {
+	a1.trueList = append(a1.trueList, a2.trueList...)
+	a1.falseList = append(a1.falseList, a2.falseList...)
+	return a1
+}
+
 // PartitioningBy returns a Collector that partitions elements by a predicate.
 func PartitioningBy[T any](predicate func(T) bool) Collector[T, partitionState[T], map[bool][]T] {
 	return partitioningByCollector[T]{predicate: predicate}
@@ -311,6 +873,16 @@ func (c toMapCollector[T, K, V]) Finisher(acc map[K]V) map[K]V {
 	return acc
 }
 
+func (c toMapCollector[T, K, V]) Combine(a1, a2 map[K]V) map[K]V {
+	for key, value := range a2 {
+		if existing, exists := a1[key]; exists && c.merger != nil {
+			value = c.merger(existing, value)
+		}
+		a1[key] = value
+	}
+	return a1
+}
+
 // ToMap returns a Collector that collects elements into a map.
 func ToMap[T any, K comparable, V any](keyFn func(T) K, valueFn func(T) V) Collector[T, map[K]V, map[K]V] {
 	return toMapCollector[T, K, V]{keyFn: keyFn, valueFn: valueFn}
@@ -321,6 +893,185 @@ func ToMapWith[T any, K comparable, V any](keyFn func(T) K, valueFn func(T) V, m
 	return toMapCollector[T, K, V]{keyFn: keyFn, valueFn: valueFn, merger: merger}
 }
 
+type toOrderedMapCollector[T any, K comparable, V any] struct {
+	keyFn   func(T) K
+	valueFn func(T) V
+	opts    []omap.Option[K, V]
+}
+
+func (c toOrderedMapCollector[T, K, V]) Supplier() omap.OrderedMap[K, V] {
+	return omap.New(c.opts...)
+}
+
+func (c toOrderedMapCollector[T, K, V]) Accumulator(acc omap.OrderedMap[K, V], elem T) omap.OrderedMap[K, V] {
+	acc.Set(c.keyFn(elem), c.valueFn(elem))
+	return acc
+}
+
+func (c toOrderedMapCollector[T, K, V]) Finisher(acc omap.OrderedMap[K, V]) omap.OrderedMap[K, V] {
+	return acc
+}
+
+func (c toOrderedMapCollector[T, K, V]) Combine(a1, a2 omap.OrderedMap[K, V]) omap.OrderedMap[K, V] {
+	a2.Range(func(key K, value V) bool {
+		a1.Set(key, value)
+		return true
+	})
+	return a1
+}
+
+// ToOrderedMap returns a Collector that collects elements into an OrderedMap, in encounter order.
+// A duplicate key takes the value of its last occurrence and, as with OrderedMap.Set, its position,
+// unless omap.WithStableOrder is given to keep the position of its first occurrence.
+func ToOrderedMap[T any, K comparable, V any](keyFn func(T) K, valueFn func(T) V, opts ...omap.Option[K, V]) Collector[T, omap.OrderedMap[K, V], omap.OrderedMap[K, V]] {
+	return toOrderedMapCollector[T, K, V]{keyFn: keyFn, valueFn: valueFn, opts: opts}
+}
+
+type toMultimapCollector[T any, K, V comparable] struct {
+	keyFn   func(T) K
+	valueFn func(T) V
+	opts    []mmap.Option[K, V]
+}
+
+func (c toMultimapCollector[T, K, V]) Supplier() mmap.Multimap[K, V] {
+	return mmap.New(c.opts...)
+}
+
+func (c toMultimapCollector[T, K, V]) Accumulator(acc mmap.Multimap[K, V], elem T) mmap.Multimap[K, V] {
+	acc.Put(c.keyFn(elem), c.valueFn(elem))
+	return acc
+}
+
+func (c toMultimapCollector[T, K, V]) Finisher(acc mmap.Multimap[K, V]) mmap.Multimap[K, V] {
+	return acc
+}
+
+func (c toMultimapCollector[T, K, V]) Combine(a1, a2 mmap.Multimap[K, V]) mmap.Multimap[K, V] {
+	a2.Range(func(key K, value V) bool {
+		a1.Put(key, value)
+		return true
+	})
+	return a1
+}
+
+// ToMultimap returns a Collector that collects elements into a Multimap, keeping every distinct
+// value of each key. The options configure the Multimap, e.g. mmap.WithMaxValuesPerKey.
+func ToMultimap[T any, K, V comparable](keyFn func(T) K, valueFn func(T) V, opts ...mmap.Option[K, V]) Collector[T, mmap.Multimap[K, V], mmap.Multimap[K, V]] {
+	return toMultimapCollector[T, K, V]{keyFn: keyFn, valueFn: valueFn, opts: opts}
+}
+
+type toConcurrentMapCollector[T any, K comparable, V any] struct {
+	keyFn   func(T) K
+	valueFn func(T) V
+	opts    []cmap.Option[K, V]
+}
+
+func (c toConcurrentMapCollector[T, K, V]) Supplier() cmap.ConcurrentMap[K, V] {
+	return cmap.New(c.opts...)
+}
+
+func (c toConcurrentMapCollector[T, K, V]) Accumulator(acc cmap.ConcurrentMap[K, V], elem T) cmap.ConcurrentMap[K, V] {
+	acc.Set(c.keyFn(elem), c.valueFn(elem))
+	return acc
+}
+
+func (c toConcurrentMapCollector[T, K, V]) Finisher(acc cmap.ConcurrentMap[K, V]) cmap.ConcurrentMap[K, V] {
+	return acc
+}
+
+func (c toConcurrentMapCollector[T, K, V]) Combine(a1, a2 cmap.ConcurrentMap[K, V]) cmap.ConcurrentMap[K, V] {
+	a2.Range(func(key K, value V) bool {
+		a1.Set(key, value)
+		return true
+	})
+	return a1
+}
+
+// ToConcurrentMap returns a Collector that collects elements into a ConcurrentMap, ready to be
+// shared between goroutines. When a key occurs more than once, the last value wins.
+// The options configure the ConcurrentMap, e.g. cmap.WithLenCounter.
+func ToConcurrentMap[T any, K comparable, V any](keyFn func(T) K, valueFn func(T) V, opts ...cmap.Option[K, V]) Collector[T, cmap.ConcurrentMap[K, V], cmap.ConcurrentMap[K, V]] {
+	return toConcurrentMapCollector[T, K, V]{keyFn: keyFn, valueFn: valueFn, opts: opts}
+}
+
+// Index is the result of Indexing: one mmap.Multimap per key function, each mapping
+// keys to the distinct elements that produced them. Indexes are numbered in the order
+// of the key functions passed to Indexing.
+type Index[K, T comparable] struct {
+	maps []mmap.Multimap[K, T]
+}
+
+// Lookup returns the elements whose key in index i equals key, in unspecified order,
+// or an empty slice if there are none. Panics if i is out of range.
+func (x Index[K, T]) Lookup(i int, key K) []T {
+	return x.maps[i].Get(key)
+}
+
+// Contains reports whether any element has key in index i. Panics if i is out of range.
+func (x Index[K, T]) Contains(i int, key K) bool {
+	return x.maps[i].ContainsKey(key)
+}
+
+// Keys returns the distinct keys of index i, in unspecified order. Panics if i is out of range.
+func (x Index[K, T]) Keys(i int) []K {
+	return x.maps[i].Keys()
+}
+
+// Multimap returns index i itself, for operations not covered by Index. Panics if i is out of range.
+func (x Index[K, T]) Multimap(i int) *mmap.Multimap[K, T] {
+	return &x.maps[i]
+}
+
+// Len returns the number of indexes.
+func (x Index[K, T]) Len() int {
+	return len(x.maps)
+}
+
+type indexingCollector[T, K comparable] struct {
+	keyFns []func(T) K
+}
+
+func (c indexingCollector[T, K]) Supplier() Index[K, T] {
+	maps := make([]mmap.Multimap[K, T], len(c.keyFns))
+	for i := range maps {
+		maps[i] = mmap.New[K, T]()
+	}
+	return Index[K, T]{maps: maps}
+}
+
+func (c indexingCollector[T, K]) Accumulator(acc Index[K, T], elem T) Index[K, T] {
+	for i, keyFn := range c.keyFns {
+		acc.maps[i].Put(keyFn(elem), elem)
+	}
+	return acc
+}
+
+func (c indexingCollector[T, K]) Finisher(acc Index[K, T]) Index[K, T] {
+	return acc
+}
+
+func (c indexingCollector[T, K]) Combine(a1, a2 Index[K, T]) Index[K, T] {
+	for i := range a1.maps {
+		a2.maps[i].Range(func(key K, elem T) bool {
+			a1.maps[i].Put(key, elem)
+			return true
+		})
+	}
+	return a1
+}
+
+// Indexing returns a Collector that builds several indexes over the elements in one pass,
+// one per key function, such as users by email, by organization and by role:
+//
+//	const byEmail, byOrg, byRole = 0, 1, 2
+//	index := stream.CollectTo(users, collectors.Indexing(User.Email, User.Org, User.Role))
+//	admins := index.Lookup(byRole, "admin")
+//
+// Each index is an mmap.Multimap, so an element appears once per distinct key.
+func Indexing[T, K comparable](keyFns ...func(T) K) Collector[T, Index[K, T], Index[K, T]] {
+	return indexingCollector[T, K]{keyFns: slices.Clone(keyFns)}
+}
+
 type statsState struct {
 	count int64
 	sum   float64
@@ -377,7 +1128,272 @@ func (c summarizingCollector[T]) Finisher(acc statsState) Statistics {
 	}
 }
 
+func (c summarizingCollector[T]) Combine(a1, a2 statsState) statsState {
+	if a2.count == 0 {
+		return a1
+	}
+	if a1.count == 0 {
+		return a2
+	}
+	a1.min = math.Min(a1.min, a2.min)
+	a1.max = math.Max(a1.max, a2.max)
+	a1.sum += a2.sum
+	a1.count += a2.count
+	return a1
+}
+
 // Summarizing returns a Collector that computes statistics for numeric values.
 func Summarizing[T any](mapper func(T) float64) Collector[T, statsState, Statistics] {
 	return summarizingCollector[T]{mapper: mapper}
 }
+
+// Bucket is one bucket of a Histogram, counting the values v with Lower <= v < Upper.
+type Bucket struct {
+	Lower float64
+	Upper float64
+	Count int64
+}
+
+type histogramCollector[T any] struct {
+	mapper func(T) float64
+	bounds []float64
+}
+
+func (c histogramCollector[T]) Supplier() []int64 {
+	return make([]int64, len(c.bounds)+1)
+}
+
+func (c histogramCollector[T]) Accumulator(acc []int64, elem T) []int64 {
+	value := c.mapper(elem)
+	i, _ := slices.BinarySearchFunc(c.bounds, value, func(bound, v float64) int {
+		if bound <= v {
+			return -1
+		}
+		return 1
+	})
+	acc[i]++
+	return acc
+}
+
+func (c histogramCollector[T]) Finisher(acc []int64) []Bucket {
+	buckets := make([]Bucket, len(acc))
+	lower := math.Inf(-1)
+	for i, count := range acc {
+		upper := math.Inf(1)
+		if i < len(c.bounds) {
+			upper = c.bounds[i]
+		}
+		buckets[i] = Bucket{Lower: lower, Upper: upper, Count: count}
+		lower = upper
+	}
+	return buckets
+}
+
+func (c histogramCollector[T]) Combine(a1, a2 []int64) []int64 {
+	for i, count := range a2 {
+		a1[i] += count
+	}
+	return a1
+}
+
+// Histogram returns a Collector that counts the values extracted by mapper per bucket.
+// The bounds split the real line into len(bounds)+1 buckets: (-Inf, bounds[0]), [bounds[0], bounds[1]),
+// ..., [bounds[n-1], +Inf). Every bucket is present in the result, even when empty.
+// Panics if the bounds are not strictly increasing.
+//
+// Use HistogramBy to bucket elements with an arbitrary function instead.
+func Histogram[T any](mapper func(T) float64, bounds ...float64) Collector[T, []int64, []Bucket] {
+	for i := 1; i < len(bounds); i++ {
+		if !(bounds[i-1] < bounds[i]) {
+			panic("collectors: histogram bounds must be strictly increasing")
+		}
+	}
+	return histogramCollector[T]{mapper: mapper, bounds: slices.Clone(bounds)}
+}
+
+// HistogramBy returns a Collector that counts the elements per bucket computed by bucketFn,
+// such as a status class or a rounded latency. Only non-empty buckets are present in the result.
+func HistogramBy[T any, K comparable](bucketFn func(T) K) Collector[T, map[K]int64, map[K]int64] {
+	return GroupingByWith(bucketFn, Counting[T]())
+}
+
+type tee2State[A1, A2 any] struct {
+	first  A1
+	second A2
+}
+
+// Tee2Result holds the results of the two collectors combined by Tee2.
+type Tee2Result[R1, R2 any] struct {
+	First  R1
+	Second R2
+}
+
+type tee2Collector[T, A1, R1, A2, R2 any] struct {
+	first  Collector[T, A1, R1]
+	second Collector[T, A2, R2]
+}
+
+func (c tee2Collector[T, A1, R1, A2, R2]) Supplier() tee2State[A1, A2] {
+	return tee2State[A1, A2]{first: c.first.Supplier(), second: c.second.Supplier()}
+}
+
+func (c tee2Collector[T, A1, R1, A2, R2]) Accumulator(acc tee2State[A1, A2], elem T) tee2State[A1, A2] {
+	acc.first = c.first.Accumulator(acc.first, elem)
+	acc.second = c.second.Accumulator(acc.second, elem)
+	return acc
+}
+
+func (c tee2Collector[T, A1, R1, A2, R2]) Finisher(acc tee2State[A1, A2]) Tee2Result[R1, R2] {
+	return Tee2Result[R1, R2]{First: c.first.Finisher(acc.first), Second: c.second.Finisher(acc.second)}
+}
+
+func (c tee2Collector[T, A1, R1, A2, R2]) Combine(a1, a2 tee2State[A1, A2]) tee2State[A1, A2] {
+	a1.first = combine(c.first, a1.first, a2.first)
+	a1.second = combine(c.second, a1.second, a2.second)
+	return a1
+}
+
+func (c tee2Collector[T, A1, R1, A2, R2]) canCombine() bool {
+	return canCombine(c.first) && canCombine(c.second)
+}
+
+func (c tee2Collector[T, A1, R1, A2, R2]) Done(acc tee2State[A1, A2]) bool {
+	return isDone(c.first, acc.first) && isDone(c.second, acc.second)
+}
+
+// Tee2 returns a Collector that feeds every element to both collectors in a single pass
+// and returns both results. It short-circuits once both collectors are done.
+func Tee2[T, A1, R1, A2, R2 any](first Collector[T, A1, R1], second Collector[T, A2, R2]) Collector[T, tee2State[A1, A2], Tee2Result[R1, R2]] {
+	return tee2Collector[T, A1, R1, A2, R2]{first: first, second: second}
+}
+
+type erasedCollector[T, A, R any] struct {
+	c Collector[T, A, R]
+}
+
+func (c erasedCollector[T, A, R]) Supplier() any {
+	return c.c.Supplier()
+}
+
+func (c erasedCollector[T, A, R]) Accumulator(acc any, elem T) any {
+	return c.c.Accumulator(acc.(A), elem)
+}
+
+func (c erasedCollector[T, A, R]) Finisher(acc any) any {
+	return c.c.Finisher(acc.(A))
+}
+
+func (c erasedCollector[T, A, R]) Combine(a1, a2 any) any {
+	return combine(c.c, a1.(A), a2.(A))
+}
+
+func (c erasedCollector[T, A, R]) canCombine() bool {
+	return canCombine(c.c)
+}
+
+func (c erasedCollector[T, A, R]) Done(acc any) bool {
+	return isDone(c.c, acc.(A))
+}
+
+// Erase hides the accumulator and result types of a Collector behind any,
+// so collectors of different types can be passed together to TeeN or used with Stream.Collect.
+func Erase[T, A, R any](c Collector[T, A, R]) Collector[T, any, any] {
+	return erasedCollector[T, A, R]{c: c}
+}
+
+type teeNCollector[T any] struct {
+	collectors []Collector[T, any, any]
+}
+
+func (c teeNCollector[T]) Supplier() []any {
+	accs := make([]any, len(c.collectors))
+	for i, col := range c.collectors {
+		accs[i] = col.Supplier()
+	}
+	return accs
+}
+
+func (c teeNCollector[T]) Accumulator(acc []any, elem T) []any {
+	for i, col := range c.collectors {
+		acc[i] = col.Accumulator(acc[i], elem)
+	}
+	return acc
+}
+
+func (c teeNCollector[T]) Finisher(acc []any) []any {
+	results := make([]any, len(c.collectors))
+	for i, col := range c.collectors {
+		results[i] = col.Finisher(acc[i])
+	}
+	return results
+}
+
+func (c teeNCollector[T]) Combine(a1, a2 []any) []any {
+	return combineAll(c.collectors, a1, a2)
+}
+
+func (c teeNCollector[T]) canCombine() bool {
+	return canCombineAll(c.collectors)
+}
+
+func (c teeNCollector[T]) Done(acc []any) bool {
+	return allDone(c.collectors, acc)
+}
+
+// TeeN returns a Collector that feeds every element to all collectors in a single pass.
+// The results are returned in the same order as the collectors.
+// Use Erase to pass collectors of different types.
+func TeeN[T any](collectors ...Collector[T, any, any]) Collector[T, []any, []any] {
+	return teeNCollector[T]{collectors: collectors}
+}
+
+type teeingCollector[T, A1, R1, A2, R2, R any] struct {
+	tee2Collector[T, A1, R1, A2, R2]
+	merger func(R1, R2) R
+}
+
+func (c teeingCollector[T, A1, R1, A2, R2, R]) Finisher(acc tee2State[A1, A2]) R {
+	result := c.tee2Collector.Finisher(acc)
+	return c.merger(result.First, result.Second)
+}
+
+// Teeing is Tee2 with the two results combined by merger instead of returned as a Tee2Result.
+func Teeing[T, A1, R1, A2, R2, R any](first Collector[T, A1, R1], second Collector[T, A2, R2], merger func(R1, R2) R) Collector[T, tee2State[A1, A2], R] {
+	return teeingCollector[T, A1, R1, A2, R2, R]{
+		tee2Collector: tee2Collector[T, A1, R1, A2, R2]{first: first, second: second},
+		merger:        merger,
+	}
+}
+
+type teeingNCollector[T, R, S any] struct {
+	teeNCollector[T]
+	merger func([]R) S
+}
+
+func (c teeingNCollector[T, R, S]) Finisher(acc []any) S {
+	results := make([]R, len(c.collectors))
+	for i, result := range c.teeNCollector.Finisher(acc) {
+		results[i], _ = result.(R)
+	}
+	return c.merger(results)
+}
+
+// TeeingN is TeeN with the results, in the same order as the collectors, combined by merger.
+// Since all collectors share a result type, they need not be wrapped with Erase.
+func TeeingN[T, A, R, S any](merger func([]R) S, collectors ...Collector[T, A, R]) Collector[T, []any, S] {
+	erased := make([]Collector[T, any, any], len(collectors))
+	for i, c := range collectors {
+		erased[i] = Erase(c)
+	}
+	return teeingNCollector[T, R, S]{teeNCollector: teeNCollector[T]{collectors: erased}, merger: merger}
+}
+
+// allDone reports whether every collector is done with its accumulator.
+func allDone[T, A, R any](collectors []Collector[T, A, R], accs []A) bool {
+	for i, col := range collectors {
+		if !isDone(col, accs[i]) {
+			return false
+		}
+	}
+	return true
+}
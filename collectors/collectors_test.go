@@ -1,7 +1,18 @@
 package collectors
 
 import (
+	"fmt"
+	"iter"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/marouanesouiri/stdx/cmap"
+	"github.com/marouanesouiri/stdx/mmap"
+	"github.com/marouanesouiri/stdx/optional"
 )
 
 func TestToSlice(t *testing.T) {
@@ -59,6 +70,47 @@ func TestJoiningWith(t *testing.T) {
 	}
 }
 
+type point struct{ x, y int }
+
+func (p point) String() string { return fmt.Sprintf("(%d,%d)", p.x, p.y) }
+
+func TestJoiningBy(t *testing.T) {
+	result := collectAll(JoiningBy(", ", strconv.Itoa), 1, 2, 3)
+	if result != "1, 2, 3" {
+		t.Errorf("expected 1, 2, 3, got %s", result)
+	}
+
+	result = collectAll(JoiningStringer[point](" "), point{1, 2}, point{3, 4})
+	if result != "(1,2) (3,4)" {
+		t.Errorf("expected (1,2) (3,4), got %s", result)
+	}
+
+	converted := 0
+	collector := JoiningBy(",", func(x int) string {
+		converted++
+		return strconv.Itoa(x)
+	}, WithMaxLength(6))
+	acc := collector.Supplier()
+	for i := 100; i < 110 && !isDone(collector, acc); i++ {
+		acc = collector.Accumulator(acc, i)
+	}
+	if result := collector.Finisher(acc); result != "100,10..." {
+		t.Errorf("expected 100,10..., got %s", result)
+	}
+	if converted != 2 {
+		t.Errorf("expected 2 conversions before short-circuiting, got %d", converted)
+	}
+
+	result = collectAll(JoiningBy("", func(s string) string { return s }, WithMaxLength(4)), "héé")
+	if result != "hé..." {
+		t.Errorf("expected truncation on a rune boundary, got %s", result)
+	}
+	result = collectAll(JoiningBy(",", strconv.Itoa, WithMaxLength(5)), 1, 2, 3)
+	if result != "1,2,3" {
+		t.Errorf("expected 1,2,3, got %s", result)
+	}
+}
+
 func TestCounting(t *testing.T) {
 	collector := Counting[int]()
 	acc := collector.Supplier()
@@ -115,6 +167,30 @@ func TestAveraging(t *testing.T) {
 	}
 }
 
+func TestSummingBig(t *testing.T) {
+	result := collectAll(SummingBig(func(x int64) int64 { return x }), math.MaxInt64, math.MaxInt64, 2)
+	if result.String() != "18446744073709551616" {
+		t.Errorf("expected 18446744073709551616, got %s", result)
+	}
+}
+
+func TestAveragingPrecise(t *testing.T) {
+	values := []float64{1e16}
+	for range 1000 {
+		values = append(values, 1)
+	}
+	values = append(values, -1e16)
+
+	identity := func(x float64) float64 { return x }
+	expected := 1000.0 / 1002
+	if result := collectAll(AveragingPrecise(identity), values...); result != expected {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+	if result := collectAll(Averaging(identity), values...); result == expected {
+		t.Errorf("expected plain Averaging to lose precision on this input, got %v", result)
+	}
+}
+
 func TestMinBy(t *testing.T) {
 	collector := MinBy(func(a, b int) bool { return a < b })
 	acc := collector.Supplier()
@@ -245,6 +321,381 @@ func TestSummarizing(t *testing.T) {
 	}
 }
 
+// collectAll runs a collector over all values.
+func collectAll[T, A, R any](collector Collector[T, A, R], values ...T) R {
+	acc := collector.Supplier()
+	for _, v := range values {
+		acc = collector.Accumulator(acc, v)
+	}
+	return collector.Finisher(acc)
+}
+
+func TestGroupingByWith(t *testing.T) {
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	firstLetter := func(s string) byte { return s[0] }
+
+	counts := collectAll(GroupingByWith(firstLetter, Counting[string]()), words...)
+	if len(counts) != 3 || counts['a'] != 2 || counts['b'] != 2 || counts['c'] != 1 {
+		t.Errorf("expected map[a:2 b:2 c:1], got %v", counts)
+	}
+
+	lengths := collectAll(GroupingByWith(firstLetter, Summing(func(s string) int { return len(s) })), words...)
+	if lengths['a'] != 12 || lengths['b'] != 15 {
+		t.Errorf("expected total lengths 12 for a and 15 for b, got %v", lengths)
+	}
+}
+
+func TestBucketingBy(t *testing.T) {
+	type event struct {
+		at    time.Time
+		value int
+	}
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	collector := BucketingBy(
+		func(e event) time.Time { return e.at },
+		time.Minute,
+		Summing(func(e event) int { return e.value }),
+	)
+	result := collectAll(collector,
+		event{base.Add(5 * time.Second), 1},
+		event{base.Add(50 * time.Second), 2},
+		event{base.Add(70 * time.Second), 10},
+	)
+	if len(result) != 2 {
+		t.Fatalf("expected 2 buckets, got %d", len(result))
+	}
+	if result[base] != 3 {
+		t.Errorf("expected sum 3 for first minute, got %d", result[base])
+	}
+	if result[base.Add(time.Minute)] != 10 {
+		t.Errorf("expected sum 10 for second minute, got %d", result[base.Add(time.Minute)])
+	}
+}
+
+func TestMappingFilteringFlatMapping(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+		tags []string
+	}
+	people := []person{
+		{"alice", 30, []string{"a", "b"}},
+		{"bob", 17, []string{"b"}},
+		{"carol", 30, nil},
+	}
+
+	names := collectAll(GroupingByWith(
+		func(p person) int { return p.age },
+		Mapping(func(p person) string { return p.name }, Joining(",")),
+	), people...)
+	if names[30] != "alice,carol" || names[17] != "bob" {
+		t.Errorf("expected names grouped by age, got %v", names)
+	}
+
+	adults := collectAll(GroupingByWith(
+		func(p person) int { return p.age },
+		Filtering(func(p person) bool { return p.age >= 18 }, Counting[person]()),
+	), people...)
+	if adults[30] != 2 || adults[17] != 0 || len(adults) != 2 {
+		t.Errorf("expected filtered counts with empty group kept, got %v", adults)
+	}
+
+	tags := collectAll(FlatMapping(
+		func(p person) iter.Seq[string] { return slices.Values(p.tags) },
+		ToSet[string](),
+	), people...)
+	if tags.Size() != 2 || !tags.Contains("a") || !tags.Contains("b") {
+		t.Errorf("expected tags {a, b}, got %v", tags)
+	}
+}
+
+func TestShortCircuitingCollectors(t *testing.T) {
+	if result := collectAll(First[int](), 3, 1, 2); result.Get() != 3 {
+		t.Errorf("expected first 3, got %v", result)
+	}
+	if result := collectAll(First[int]()); result.IsPresent() {
+		t.Errorf("expected no first element, got %v", result)
+	}
+	if !collectAll(AnyMatch(func(x int) bool { return x > 2 }), 1, 3) {
+		t.Error("expected AnyMatch to be true")
+	}
+	if !collectAll(AllMatch(func(x int) bool { return x > 2 })) {
+		t.Error("expected AllMatch to be true for no elements")
+	}
+
+	even := func(x int) bool { return x%2 == 0 }
+	tests := []struct {
+		name     string
+		done     func(values ...int) bool
+		values   []int
+		expected bool
+	}{
+		{"First", doneAfter(First[int]()), []int{1}, true},
+		{"AnyMatch", doneAfter(AnyMatch(even)), []int{1, 3}, false},
+		{"AllMatch", doneAfter(AllMatch(even)), []int{2, 3}, true},
+		{"Filtering", doneAfter(Filtering(even, First[int]())), []int{1, 3}, false},
+		{"Mapping", doneAfter(Mapping(even, AnyMatch(func(b bool) bool { return b }))), []int{4}, true},
+		{"Erase", doneAfter(Erase(First[int]())), []int{1}, true},
+		{"Tee2", doneAfter(Tee2(First[int](), AnyMatch(even))), []int{1}, false},
+		{"Tee2Both", doneAfter(Tee2(First[int](), AnyMatch(even))), []int{1, 2}, true},
+		{"NotShortCircuiting", doneAfter(Counting[int]()), []int{1, 2}, false},
+	}
+	for _, tt := range tests {
+		if done := tt.done(tt.values...); done != tt.expected {
+			t.Errorf("%s: expected done %v, got %v", tt.name, tt.expected, done)
+		}
+	}
+}
+
+// doneAfter returns a function reporting whether collector is done after accumulating values.
+func doneAfter[T, A, R any](collector Collector[T, A, R]) func(values ...T) bool {
+	return func(values ...T) bool {
+		acc := collector.Supplier()
+		for _, v := range values {
+			acc = collector.Accumulator(acc, v)
+		}
+		return isDone(collector, acc)
+	}
+}
+
+func TestContainerCollectors(t *testing.T) {
+	words := []string{"banana", "apple", "blueberry", "avocado", "banana"}
+	first := func(s string) byte { return s[0] }
+	length := func(s string) int { return len(s) }
+
+	ordered := collectAll(ToOrderedMap(func(s string) string { return s }, length), words...)
+	keys := ordered.Keys()
+	if len(keys) != 4 || keys[0] != "apple" || keys[3] != "banana" {
+		t.Errorf("expected keys in encounter order with banana last, got %v", keys)
+	}
+
+	multi := collectAll(ToMultimap(first, func(s string) string { return s }), words...)
+	if multi.Size() != 4 || multi.KeySize('a') != 2 || multi.KeySize('b') != 2 {
+		t.Errorf("expected 2 distinct words per letter, got %v", multi.String())
+	}
+
+	capped := collectAll(ToMultimap(first, length, mmap.WithMaxValuesPerKey[byte, int](1, mmap.RejectNew)), words...)
+	if capped.Size() != 2 {
+		t.Errorf("expected one value per key, got %d", capped.Size())
+	}
+
+	concurrent := collectAll(ToConcurrentMap(first, length, cmap.WithLenCounter[byte, int]()), words...)
+	if concurrent.Len() != 2 || concurrent.Get('a').OrElse(0) != 7 || concurrent.Get('b').OrElse(0) != 6 {
+		t.Errorf("expected last values per letter, got %v", concurrent.Items())
+	}
+}
+
+func TestIndexing(t *testing.T) {
+	type user struct{ email, org, role string }
+	users := []user{
+		{"ann@a.io", "a", "admin"},
+		{"bob@a.io", "a", "dev"},
+		{"cid@b.io", "b", "admin"},
+	}
+	const byEmail, byOrg, byRole = 0, 1, 2
+	index := collectAll(Indexing(
+		func(u user) string { return u.email },
+		func(u user) string { return u.org },
+		func(u user) string { return u.role },
+	), users...)
+
+	if index.Len() != 3 {
+		t.Errorf("expected 3 indexes, got %d", index.Len())
+	}
+	if found := index.Lookup(byEmail, "bob@a.io"); len(found) != 1 || found[0] != users[1] {
+		t.Errorf("expected bob, got %v", found)
+	}
+	if found := index.Lookup(byOrg, "a"); len(found) != 2 {
+		t.Errorf("expected 2 users in org a, got %v", found)
+	}
+	if index.Contains(byRole, "guest") || len(index.Keys(byRole)) != 2 || index.Multimap(byRole).KeySize("admin") != 2 {
+		t.Errorf("unexpected role index %v", index.Multimap(byRole).String())
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	identity := func(x float64) float64 { return x }
+	buckets := collectAll(Histogram(identity, 10, 100), 1, 5, 10, 50, 99, 100, 1000)
+	expected := []int64{2, 3, 2}
+	if len(buckets) != 3 {
+		t.Fatalf("expected 3 buckets, got %d", len(buckets))
+	}
+	for i, b := range buckets {
+		if b.Count != expected[i] {
+			t.Errorf("expected count %d in bucket %d, got %d", expected[i], i, b.Count)
+		}
+	}
+	if !math.IsInf(buckets[0].Lower, -1) || buckets[0].Upper != 10 || buckets[2].Lower != 100 || !math.IsInf(buckets[2].Upper, 1) {
+		t.Errorf("unexpected bucket bounds %+v", buckets)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for unsorted bounds")
+		}
+	}()
+	Histogram(identity, 10, 5)
+}
+
+func TestHistogramBy(t *testing.T) {
+	classes := collectAll(HistogramBy(func(code int) int { return code / 100 }), 200, 204, 404, 500, 201)
+	if classes[2] != 3 || classes[4] != 1 || classes[5] != 1 {
+		t.Errorf("expected status class counts, got %v", classes)
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	values := make([]float64, 10000)
+	for i := range values {
+		values[i] = float64(i + 1)
+	}
+	rand.New(rand.NewSource(1)).Shuffle(len(values), func(i, j int) {
+		values[i], values[j] = values[j], values[i]
+	})
+
+	quantiles := []float64{0, 0.5, 0.9, 0.99, 1}
+	result := collectAll(Percentiles(func(x float64) float64 { return x }, quantiles...), values...)
+	for i, q := range quantiles {
+		exact := math.Max(1, q*10000)
+		if math.Abs(result[i]-exact) > 100 {
+			t.Errorf("expected quantile %v near %v, got %v", q, exact, result[i])
+		}
+	}
+	if result[0] != 1 || result[4] != 10000 {
+		t.Errorf("expected exact min and max, got %v and %v", result[0], result[4])
+	}
+
+	small := collectAll(Percentiles(func(x float64) float64 { return x }, 0.5), 3, 1, 2)
+	if small[0] != 2 {
+		t.Errorf("expected exact median 2, got %v", small[0])
+	}
+	empty := collectAll(Percentiles(func(x float64) float64 { return x }, 0.5))
+	if !math.IsNaN(empty[0]) {
+		t.Errorf("expected NaN for empty input, got %v", empty[0])
+	}
+}
+
+func TestParallelCombine(t *testing.T) {
+	values := []int{5, 3, 8, 1, 9, 2, 7, 4, 6}
+	identity := func(x int) int { return x }
+	toFloat := func(x int) float64 { return float64(x) }
+	tests := []struct {
+		name  string
+		check func([]int) (combined, sequential string)
+	}{
+		{"Counting", compareCombined(Counting[int]())},
+		{"Summing", compareCombined(Summing(identity))},
+		{"MinBy", compareCombined(MinBy(func(a, b int) bool { return a < b }))},
+		{"Summarizing", compareCombined(Summarizing(toFloat))},
+		{"Histogram", compareCombined(Histogram(toFloat, 3, 6))},
+		{"Joining", compareCombined(Mapping(strconv.Itoa, Joining(",")))},
+		{"Indexing", compareCombined(Indexing(func(x int) int { return x % 3 }, func(x int) int { return x / 4 }))},
+		{"JoiningBy", compareCombined(JoiningBy(",", strconv.Itoa, WithMaxLength(12)))},
+		{"GroupingByWith", compareCombined(GroupingByWith(func(x int) bool { return x%2 == 0 }, Summing(identity)))},
+		{"Tee2", compareCombined(Tee2(Counting[int](), AllMatch(func(x int) bool { return x > 0 })))},
+	}
+	for _, tt := range tests {
+		if combined, sequential := tt.check(values); combined != sequential {
+			t.Errorf("%s: expected %s, got %s", tt.name, sequential, combined)
+		}
+	}
+
+	if _, ok := Parallel(Percentiles(toFloat, 0.5)); ok {
+		t.Error("expected Percentiles not to be parallel")
+	}
+	if _, ok := Parallel(GroupingByWith(identity, Percentiles(toFloat, 0.5))); ok {
+		t.Error("expected GroupingByWith over Percentiles not to be parallel")
+	}
+	if _, ok := Parallel(Erase(TeeN(Erase(Counting[int]()), Erase(ToSlice[int]())))); !ok {
+		t.Error("expected nested tee of parallel collectors to be parallel")
+	}
+}
+
+// compareCombined returns a function collecting values both sequentially and by combining
+// the accumulators of each half, formatting both results for comparison.
+func compareCombined[T, A, R any](c Collector[T, A, R]) func([]T) (string, string) {
+	return func(values []T) (string, string) {
+		pc, ok := Parallel(c)
+		if !ok {
+			return "not parallel", ""
+		}
+		mid := len(values) / 2
+		a1, a2 := pc.Supplier(), pc.Supplier()
+		for _, v := range values[:mid] {
+			a1 = pc.Accumulator(a1, v)
+		}
+		for _, v := range values[mid:] {
+			a2 = pc.Accumulator(a2, v)
+		}
+		return fmt.Sprint(pc.Finisher(pc.Combine(a1, a2))), fmt.Sprint(collectAll(c, values...))
+	}
+}
+
+func TestTee2(t *testing.T) {
+	collector := Tee2(Counting[int](), Summing(func(x int) int { return x }))
+	result := collectAll(collector, 1, 2, 3, 4)
+	if result.First != 4 {
+		t.Errorf("expected count 4, got %d", result.First)
+	}
+	if result.Second != 10 {
+		t.Errorf("expected sum 10, got %d", result.Second)
+	}
+}
+
+func TestTeeN(t *testing.T) {
+	collector := TeeN(
+		Erase(Counting[int]()),
+		Erase(Summing(func(x int) int { return x })),
+		Erase(ToSlice[int]()),
+	)
+	result := collectAll(collector, 1, 2, 3)
+	if result[0].(int64) != 3 {
+		t.Errorf("expected count 3, got %v", result[0])
+	}
+	if result[1].(int) != 6 {
+		t.Errorf("expected sum 6, got %v", result[1])
+	}
+	if len(result[2].([]int)) != 3 {
+		t.Errorf("expected 3 elements, got %v", result[2])
+	}
+}
+
+func TestTeeing(t *testing.T) {
+	collector := Teeing(
+		Counting[int](),
+		GroupingByWith(func(x int) bool { return x%2 == 0 }, Counting[int]()),
+		func(total int64, byParity map[bool]int64) float64 {
+			return float64(byParity[true]) / float64(total)
+		},
+	)
+	if result := collectAll(collector, 1, 2, 3, 4); result != 0.5 {
+		t.Errorf("expected even ratio 0.5, got %v", result)
+	}
+}
+
+func TestTeeingN(t *testing.T) {
+	collector := TeeingN(
+		func(sums []int) []int { return sums },
+		Summing(func(x int) int { return x }),
+		Summing(func(x int) int { return x * x }),
+		Summing(func(x int) int { return x * x * x }),
+	)
+	result := collectAll(collector, 1, 2, 3)
+	if len(result) != 3 || result[0] != 6 || result[1] != 14 || result[2] != 36 {
+		t.Errorf("expected [6 14 36], got %v", result)
+	}
+
+	// TeeingN shares TeeN's short-circuiting and parallel support.
+	first := TeeingN(func(firsts []optional.Option[int]) int { return len(firsts) }, First[int](), First[int]())
+	if acc := first.Accumulator(first.Supplier(), 1); !isDone(first, acc) {
+		t.Error("expected TeeingN to be done once every collector is done")
+	}
+	combined, sequential := compareCombined(collector)([]int{1, 2, 3, 4})
+	if combined != sequential {
+		t.Errorf("expected combined result %s, got %s", sequential, combined)
+	}
+}
+
 func BenchmarkToSlice(b *testing.B) {
 	data := make([]int, 1000)
 	for i := range data {
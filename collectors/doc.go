@@ -21,26 +21,56 @@
 // Collection Collectors:
 //   - ToSlice: Collect elements into a slice
 //   - ToSet: Collect elements into a Set (removes duplicates)
+//   - ToOrderedMap: Collect elements into an omap.OrderedMap in encounter order
+//   - ToMultimap: Collect elements into an mmap.Multimap
+//   - Indexing: Build several mmap.Multimap indexes over the elements in one pass
+//   - ToConcurrentMap: Collect elements into a cmap.ConcurrentMap
 //
 // String Collectors:
 //   - Joining: Join strings with a separator
 //   - JoiningWith: Join strings with separator, prefix, and suffix
+//   - JoiningBy: Join any elements converted to strings, optionally truncated with WithMaxLength
+//   - JoiningStringer: Join fmt.Stringer elements, optionally truncated with WithMaxLength
 //
 // Numeric Collectors:
 //   - Counting: Count the number of elements
 //   - Summing: Sum numeric values extracted by a mapper function
 //   - Averaging: Compute the average of numeric values
+//   - SummingBig: Sum int64 values into a big.Int that cannot overflow
+//   - AveragingPrecise: Compute the average with compensated (Kahan) summation
 //   - MinBy: Find the minimum element according to a comparator
 //   - MaxBy: Find the maximum element according to a comparator
 //
 // Grouping Collectors:
 //   - GroupingBy: Group elements by a key function
+//   - GroupingByWith: Group elements by a key function and reduce each group with a downstream collector
 //   - PartitioningBy: Partition elements into two groups based on a predicate
 //   - ToMap: Collect elements into a map
 //   - ToMapWith: Collect into a map with a merge function for duplicate keys
+//   - BucketingBy: Group elements into time buckets and reduce each bucket with a downstream collector
+//
+// Adapter Collectors (mostly used as downstream collectors):
+//   - Mapping: Transform elements before passing them to a downstream collector
+//   - Filtering: Pass only matching elements to a downstream collector
+//   - FlatMapping: Pass every value of a sequence derived from each element to a downstream collector
+//
+// Short-Circuiting Collectors (stop consuming the stream once the result is known):
+//   - First: Find the first element
+//   - AnyMatch: Report whether any element matches a predicate
+//   - AllMatch: Report whether all elements match a predicate
 //
 // Statistical Collectors:
 //   - Summarizing: Compute count, sum, min, max, and average in one pass
+//   - Histogram: Count values per bucket between fixed bounds
+//   - HistogramBy: Count elements per bucket computed by a function
+//   - Percentiles: Estimate quantiles in one pass with a constant-memory sketch
+//
+// Composite Collectors:
+//   - Tee2: Feed every element to two collectors in one pass
+//   - TeeN: Feed every element to any number of collectors in one pass
+//   - Teeing: Tee2 with the two results merged by a function
+//   - TeeingN: TeeN over collectors of one type, with the results merged by a function
+//   - Erase: Hide a collector's accumulator and result types behind any
 //
 // # Examples
 //
@@ -75,6 +105,36 @@
 //	)
 //	// Statistics{Count: 5, Sum: 15, Min: 1, Max: 5, Average: 3}
 //
+// Latency distribution in one pass over a large data set:
+//
+//	latency := func(r Request) float64 { return r.Latency.Seconds() }
+//	p := stream.CollectTo(requests, collectors.Percentiles(latency, 0.5, 0.95, 0.99))
+//	fmt.Printf("p50=%.3f p95=%.3f p99=%.3f\n", p[0], p[1], p[2])
+//
+//	buckets := stream.CollectTo(requests, collectors.Histogram(latency, 0.01, 0.1, 1))
+//	for _, b := range buckets {
+//	    fmt.Printf("[%v, %v): %d\n", b.Lower, b.Upper, b.Count)
+//	}
+//
+// Per-group aggregates in one pass, without building a slice per key:
+//
+//	countByLetter := stream.CollectTo(
+//	    stream.From(words),
+//	    collectors.GroupingByWith(func(s string) rune { return rune(s[0]) }, collectors.Counting[string]()),
+//	)
+//	// map[rune]int64{'a': 2, 'b': 2}
+//
+// Adapters shape what each group receives:
+//
+//	namesByAge := stream.CollectTo(
+//	    stream.From(people),
+//	    collectors.GroupingByWith(
+//	        func(p Person) int { return p.Age },
+//	        collectors.Mapping(func(p Person) string { return p.Name }, collectors.ToSlice[string]()),
+//	    ),
+//	)
+//	// map[int][]string
+//
 // Partitioning:
 //
 //	numbers := []int{1, 2, 3, 4, 5, 6}
@@ -84,6 +144,45 @@
 //	)
 //	// map[bool][]int{true: [2, 4, 6], false: [1, 3, 5]}
 //
+// Time buckets:
+//
+//	type LogLine struct {
+//	    At      time.Time
+//	    Latency float64
+//	}
+//
+//	perMinute := stream.CollectTo(
+//	    stream.From(lines),
+//	    collectors.BucketingBy(
+//	        func(l LogLine) time.Time { return l.At },
+//	        time.Minute,
+//	        collectors.Summarizing(func(l LogLine) float64 { return l.Latency }),
+//	    ),
+//	)
+//	// map[time.Time]Statistics, one entry per minute
+//
+// Several results in one pass:
+//
+//	both := stream.CollectTo(
+//	    stream.From(numbers),
+//	    collectors.Tee2(collectors.Counting[int](), collectors.Summing(func(x int) int { return x })),
+//	)
+//	fmt.Println(both.First, both.Second) // count, sum
+//
+//	all := stream.CollectTo(stream.From(numbers), collectors.TeeN(
+//	    collectors.Erase(collectors.Counting[int]()),
+//	    collectors.Erase(collectors.ToSet[int]()),
+//	)) // []any{int64, set.Set[int]}
+//
+//	// Merge the results directly, e.g. the share of even numbers
+//	ratio := stream.CollectTo(stream.From(numbers), collectors.Teeing(
+//	    collectors.Counting[int](),
+//	    collectors.GroupingByWith(func(x int) bool { return x%2 == 0 }, collectors.Counting[int]()),
+//	    func(total int64, byParity map[bool]int64) float64 {
+//	        return float64(byParity[true]) / float64(total)
+//	    },
+//	))
+//
 // Custom mapping:
 //
 //	type Person struct {
@@ -148,6 +247,33 @@
 //	    collectors.MinBy(func(a, b int) bool { return a < b }),
 //	)  // Some(1)
 //
+// # Short-Circuiting
+//
+// A collector can implement the optional ShortCircuiting interface to tell stream.CollectTo that
+// its result is final, after which no more elements are pulled from the stream:
+//
+//	type ShortCircuiting[A any] interface {
+//	    Done(acc A) bool
+//	}
+//
+// Adapters (Mapping, Filtering, FlatMapping) and Erase forward Done to their downstream collector,
+// and the tee collectors are done once all of their collectors are done. JoiningBy and
+// JoiningStringer are done once WithMaxLength truncated their output. GroupingByWith and the
+// other grouping collectors never short-circuit, since a new key may appear at any time.
+//
+// # Parallel Collection
+//
+// Collectors implementing ParallelCollector can merge partial accumulators with Combine,
+// which lets stream.ParallelCollectTo accumulate on several goroutines. Most built-in
+// collectors do; collectors wrapping others can combine only when all of them can,
+// which Parallel checks:
+//
+//	if pc, ok := collectors.Parallel(c); ok {
+//	    acc := pc.Combine(left, right)
+//	}
+//
+// Percentiles cannot be combined. Parallel collection does not preserve encounter order.
+//
 // # Performance
 //
 // Collectors are designed to be efficient:
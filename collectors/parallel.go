@@ -0,0 +1,73 @@
+package collectors
+
+// ParallelCollector is a Collector whose partial accumulators can be merged, so a stream can be
+// collected by several goroutines, each accumulating part of the elements, and the partial results
+// combined before finishing.
+//
+// Combine merges a2 into a1 and returns the result; a1 and a2 come from separate Supplier calls and
+// a2 is not used afterwards. Parallel collection does not preserve encounter order, so order-sensitive
+// collectors such as ToSlice, Joining or First return their elements in an unspecified order.
+//
+// Most built-in collectors implement it; Percentiles does not, as its sketches cannot be merged.
+// Collectors wrapping others, such as GroupingByWith, Mapping or Tee2, can only combine when all
+// the collectors they wrap can: use Parallel rather than a type assertion to check.
+type ParallelCollector[T, A, R any] interface {
+	Collector[T, A, R]
+	Combine(a1, a2 A) A
+}
+
+// wrapper is implemented by collectors that delegate to other collectors.
+// canCombine reports whether all of them are ParallelCollectors.
+type wrapper interface {
+	canCombine() bool
+}
+
+// Parallel returns c as a ParallelCollector if its partial accumulators can be combined,
+// including those of every collector it wraps.
+func Parallel[T, A, R any](c Collector[T, A, R]) (ParallelCollector[T, A, R], bool) {
+	pc, ok := c.(ParallelCollector[T, A, R])
+	if !ok {
+		return nil, false
+	}
+	if w, ok := c.(wrapper); ok && !w.canCombine() {
+		return nil, false
+	}
+	return pc, true
+}
+
+func canCombine[T, A, R any](c Collector[T, A, R]) bool {
+	_, ok := Parallel(c)
+	return ok
+}
+
+func canCombineAll[T, A, R any](collectors []Collector[T, A, R]) bool {
+	for _, c := range collectors {
+		if !canCombine(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// combine merges two accumulators of c, which must have been checked with canCombine.
+func combine[T, A, R any](c Collector[T, A, R], a1, a2 A) A {
+	return c.(ParallelCollector[T, A, R]).Combine(a1, a2)
+}
+
+func combineAll[T, A, R any](collectors []Collector[T, A, R], a1, a2 []A) []A {
+	for i, c := range collectors {
+		a1[i] = combine(c, a1[i], a2[i])
+	}
+	return a1
+}
+
+// combineGroups merges per-key accumulators of a downstream collector.
+func combineGroups[T any, K comparable, A, R any](downstream Collector[T, A, R], a1, a2 map[K]A) map[K]A {
+	for key, acc := range a2 {
+		if existing, ok := a1[key]; ok {
+			acc = combine(downstream, existing, acc)
+		}
+		a1[key] = acc
+	}
+	return a1
+}
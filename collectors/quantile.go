@@ -0,0 +1,156 @@
+package collectors
+
+import (
+	"math"
+	"slices"
+)
+
+// p2Sketch estimates a single quantile in constant memory with the P² algorithm
+// (Jain and Chlamtac, 1985). It tracks five markers: the minimum, the maximum, the
+// estimated quantile and two markers halfway to it, whose heights are adjusted with
+// piecewise-parabolic interpolation as values arrive.
+type p2Sketch struct {
+	p       float64
+	count   int
+	heights [5]float64
+	pos     [5]float64 // actual marker positions, 1-based
+	desired [5]float64 // desired marker positions
+	inc     [5]float64 // desired position increments per observation
+}
+
+func newP2Sketch(p float64) p2Sketch {
+	return p2Sketch{
+		p:       p,
+		desired: [5]float64{1, 1 + 2*p, 1 + 4*p, 3 + 2*p, 5},
+		inc:     [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}
+}
+
+func (s *p2Sketch) add(x float64) {
+	if s.count < 5 {
+		s.heights[s.count] = x
+		s.count++
+		if s.count == 5 {
+			slices.Sort(s.heights[:])
+			s.pos = [5]float64{1, 2, 3, 4, 5}
+		}
+		return
+	}
+	s.count++
+
+	var k int
+	switch {
+	case x < s.heights[0]:
+		s.heights[0] = x
+		k = 0
+	case x < s.heights[1]:
+		k = 0
+	case x < s.heights[2]:
+		k = 1
+	case x < s.heights[3]:
+		k = 2
+	case x <= s.heights[4]:
+		k = 3
+	default:
+		s.heights[4] = x
+		k = 3
+	}
+	for i := k + 1; i < 5; i++ {
+		s.pos[i]++
+	}
+	for i := range s.desired {
+		s.desired[i] += s.inc[i]
+	}
+
+	for i := 1; i <= 3; i++ {
+		d := s.desired[i] - s.pos[i]
+		if (d >= 1 && s.pos[i+1]-s.pos[i] > 1) || (d <= -1 && s.pos[i-1]-s.pos[i] < -1) {
+			step := math.Copysign(1, d)
+			h := s.parabolic(i, step)
+			if s.heights[i-1] < h && h < s.heights[i+1] {
+				s.heights[i] = h
+			} else {
+				s.heights[i] = s.linear(i, step)
+			}
+			s.pos[i] += step
+		}
+	}
+}
+
+func (s *p2Sketch) parabolic(i int, d float64) float64 {
+	q, n := &s.heights, &s.pos
+	return q[i] + d/(n[i+1]-n[i-1])*((n[i]-n[i-1]+d)*(q[i+1]-q[i])/(n[i+1]-n[i])+
+		(n[i+1]-n[i]-d)*(q[i]-q[i-1])/(n[i]-n[i-1]))
+}
+
+func (s *p2Sketch) linear(i int, d float64) float64 {
+	j := i + int(d)
+	return s.heights[i] + d*(s.heights[j]-s.heights[i])/(s.pos[j]-s.pos[i])
+}
+
+// quantile returns the estimate, computed exactly while fewer than five values were seen.
+func (s *p2Sketch) quantile() float64 {
+	switch {
+	case s.count == 0:
+		return math.NaN()
+	case s.count < 5:
+		values := slices.Clone(s.heights[:s.count])
+		slices.Sort(values)
+		return values[int(math.Round(s.p*float64(s.count-1)))]
+	case s.p == 0:
+		return s.heights[0]
+	case s.p == 1:
+		return s.heights[4]
+	default:
+		return s.heights[2]
+	}
+}
+
+type percentilesCollector[T any] struct {
+	mapper    func(T) float64
+	quantiles []float64
+}
+
+func (c percentilesCollector[T]) Supplier() []p2Sketch {
+	sketches := make([]p2Sketch, len(c.quantiles))
+	for i, q := range c.quantiles {
+		sketches[i] = newP2Sketch(q)
+	}
+	return sketches
+}
+
+func (c percentilesCollector[T]) Accumulator(acc []p2Sketch, elem T) []p2Sketch {
+	value := c.mapper(elem)
+	for i := range acc {
+		acc[i].add(value)
+	}
+	return acc
+}
+
+func (c percentilesCollector[T]) Finisher(acc []p2Sketch) []float64 {
+	results := make([]float64, len(acc))
+	for i := range acc {
+		results[i] = acc[i].quantile()
+	}
+	return results
+}
+
+// Percentiles returns a Collector that estimates the given quantiles, such as 0.5, 0.95 and 0.99,
+// of the values extracted by mapper. The results are returned in the same order as the quantiles.
+//
+// The estimates come from the P² streaming algorithm: memory is constant per quantile and every
+// value is seen once, so large data sets can be analyzed in a single pass without sorting them.
+// Estimates are exact for fewer than five values and for the quantiles 0 (minimum) and 1 (maximum);
+// otherwise they are approximations that improve with the number of values, typically within about
+// one percent for thousands of values from a smooth distribution.
+// Each quantile is NaN if the stream is empty.
+//
+// Panics if a quantile is outside [0, 1].
+func Percentiles[T any](mapper func(T) float64, quantiles ...float64) Collector[T, []p2Sketch, []float64] {
+	for _, q := range quantiles {
+		if !(q >= 0 && q <= 1) {
+			panic("collectors: quantiles must be between 0 and 1")
+		}
+	}
+	return percentilesCollector[T]{mapper: mapper, quantiles: slices.Clone(quantiles)}
+}
@@ -172,6 +172,16 @@ func (d *Deque[T]) resize(newCap int) {
 	d.mask = newCap - 1
 }
 
+// Range calls fn for each element from front to back.
+// If fn returns false, iteration stops.
+func (d *Deque[T]) Range(fn func(T) bool) {
+	for i := range d.len {
+		if !fn(d.buf[(d.head+i)&d.mask]) {
+			return
+		}
+	}
+}
+
 // Clear removes all of the elements from this deque.
 // The deque will be empty after this call returns.
 func (d *Deque[T]) Clear() {
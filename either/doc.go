@@ -123,6 +123,15 @@
 //	})
 //	fmt.Println(final.Right()) // 50
 //
+// Use Ensure to turn a failing check into a Left without writing a FlatMap:
+//
+//	age := either.Right[string, int](input).
+//	    Ensure(func(x int) bool { return x >= 0 }, "age must not be negative").
+//	    Ensure(func(x int) bool { return x < 150 }, "age is unrealistic")
+//
+//	// Or build an Either straight from a condition
+//	user := either.Ensure(ok, "user not found", u)
+//
 // # Swapping Sides
 //
 // Swap left and right values:
@@ -133,6 +142,77 @@
 //	fmt.Println(swapped.IsRight()) // true
 //	fmt.Println(swapped.Right())   // "error"
 //
+// # Bridging with Go Errors
+//
+// Err converts a Left into a standard error, so Either values integrate with
+// errors.Is and errors.As. AsError does the same when the left type is an error:
+//
+//	e := either.FromError(os.Open("config.yaml")) // Either[error, *os.File]
+//	if err := e.Err(); errors.Is(err, fs.ErrNotExist) {
+//	    // ...
+//	}
+//
+//	var pathErr *fs.PathError
+//	if errors.As(either.AsError(e), &pathErr) {
+//	    fmt.Println(pathErr.Path)
+//	}
+//
+// A Left whose value is not an error is reported as a *LeftError holding the value.
+//
+// # Working with Slices
+//
+// Split a slice of Eithers into both sides, or keep only one of them:
+//
+//	results := []either.Either[error, int]{
+//	    either.Right[error, int](1),
+//	    either.Left[error, int](errors.New("bad input")),
+//	    either.Right[error, int](3),
+//	}
+//
+//	errs, values := either.Partition(results) // [bad input], [1 3]
+//	onlyErrs := either.Lefts(results)         // [bad input]
+//	onlyValues := either.Rights(results)      // [1 3]
+//
+// Use Sequence to require that every element is a Right. The first Left short-circuits:
+//
+//	all := either.Sequence(results)
+//	if all.IsLeft() {
+//	    fmt.Println("validation failed:", all.Left()) // bad input
+//	}
+//
+// Use FirstRight for fallback chains: candidates are tried in order until one returns a Right,
+// and if none does, every Left is returned:
+//
+//	user := either.FirstRight(
+//	    func() either.Either[error, User] { return either.FromError(primary.Get(id)) },
+//	    func() either.Either[error, User] { return either.FromError(replica.Get(id)) },
+//	    func() either.Either[error, User] { return either.FromError(cache.Get(id)) },
+//	)
+//	if user.IsLeft() {
+//	    return errors.Join(user.Left()...)
+//	}
+//
+// # Accumulating Validation
+//
+// FlatMap and Sequence stop at the first Left. When every failure matters, as in form
+// validation, use Validated, which keeps all errors:
+//
+//	name := either.Valid[string]("gopher")
+//	age := either.Invalid[string, int]("age must be positive")
+//	email := either.Invalid[string, string]("email is required")
+//
+//	user := either.ZipValidated(name, age, func(n string, a int) User {
+//	    return User{Name: n, Age: a}
+//	})
+//	user = either.ZipValidated(user, email, func(u User, e string) User {
+//	    u.Email = e
+//	    return u
+//	})
+//	fmt.Println(user.Errors()) // [age must be positive email is required]
+//
+// ValidateAll is the accumulating counterpart of Sequence for plain Eithers,
+// and ZipWith merges two Left values with a user-supplied combine function.
+//
 // # JSON Serialization
 //
 // Either values serialize to JSON with type information:
@@ -158,6 +238,49 @@
 //	    fmt.Println("Error:", decoded.Result.Left())
 //	}
 //
+// # Custom JSON Encodings
+//
+// External APIs rarely use the default envelope. Register an Encoding for a specific
+// Either[L, R] instantiation to change its layout:
+//
+//	func init() {
+//	    // {"kind":"ok","payload":42}
+//	    either.RegisterEncoding[string, int](either.Encoding{
+//	        TagField:   "kind",
+//	        ValueField: "payload",
+//	        LeftTag:    "error",
+//	        RightTag:   "ok",
+//	    })
+//
+//	    // {"type":"right","id":1,"name":"gopher"}
+//	    either.RegisterEncoding[APIError, User](either.Encoding{Scheme: either.Inline})
+//
+//	    // 42 or "not found"; decoding tries the right type first, then the left type
+//	    either.RegisterEncoding[string, float64](either.Encoding{Scheme: either.Untagged})
+//	}
+//
+// Inline requires both sides to marshal as JSON objects. Untagged only round-trips
+// when the JSON shapes of L and R can be told apart.
+//
+// # Three-Way Unions
+//
+// Either3 holds one of three types, avoiding nested Eithers:
+//
+//	type Warning string
+//
+//	func parse(input string) either.Either3[Config, Warning, error] {
+//	    // ...
+//	    return either.Second[Config, Warning, error]("deprecated key ignored")
+//	}
+//
+//	msg := either.Fold3(parse(input),
+//	    func(c Config) string { return "ok" },
+//	    func(w Warning) string { return "warning: " + string(w) },
+//	    func(err error) string { return "fatal: " + err.Error() },
+//	)
+//
+// Either3 serializes to JSON as {"type":"first"|"second"|"third","value":...}.
+//
 // # When to Use Either
 //
 // Use Either when:
@@ -1,7 +1,6 @@
 package either
 
 import (
-	"encoding/json"
 	"fmt"
 )
 
@@ -162,6 +161,23 @@ func (e Either[L, R]) OrElse(alternative Either[L, R]) Either[L, R] {
 	return alternative
 }
 
+// Ensure returns this Either unchanged if it's a Left or if the predicate holds for the right value.
+// Otherwise it returns a Left holding leftVal.
+func (e Either[L, R]) Ensure(predicate func(R) bool, leftVal L) Either[L, R] {
+	if e.isLeft || predicate(e.right) {
+		return e
+	}
+	return Left[L, R](leftVal)
+}
+
+// Ensure builds an Either from a condition: Right(rightVal) if cond is true, otherwise Left(leftVal).
+func Ensure[L, R any](cond bool, leftVal L, rightVal R) Either[L, R] {
+	if !cond {
+		return Left[L, R](leftVal)
+	}
+	return Right[L](rightVal)
+}
+
 // String returns a string representation of the Either.
 func (e Either[L, R]) String() string {
 	if e.isLeft {
@@ -170,57 +186,69 @@ func (e Either[L, R]) String() string {
 	return fmt.Sprintf("Right(%v)", e.right)
 }
 
-// eitherJSON is used for JSON marshaling to include type information.
-type eitherJSON struct {
-	Type  string `json:"type"`
-	Value any    `json:"value"`
+// Partition splits a slice of Eithers into its left values and its right values,
+// preserving the relative order of each side.
+func Partition[L, R any](eithers []Either[L, R]) ([]L, []R) {
+	lefts := make([]L, 0)
+	rights := make([]R, 0)
+	for _, e := range eithers {
+		if e.isLeft {
+			lefts = append(lefts, e.left)
+		} else {
+			rights = append(rights, e.right)
+		}
+	}
+	return lefts, rights
 }
 
-// MarshalJSON implements json.Marshaler.
-// The Either is marshaled as an object with "type" (either "left" or "right") and "value" fields.
-func (e Either[L, R]) MarshalJSON() ([]byte, error) {
-	if e.isLeft {
-		return json.Marshal(eitherJSON{
-			Type:  "left",
-			Value: e.left,
-		})
-	}
-	return json.Marshal(eitherJSON{
-		Type:  "right",
-		Value: e.right,
-	})
-}
-
-// UnmarshalJSON implements json.Unmarshaler.
-// Expects a JSON object with "type" and "value" fields.
-func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
-	var ej eitherJSON
-	if err := json.Unmarshal(data, &ej); err != nil {
-		return err
-	}
-
-	switch ej.Type {
-	case "left":
-		valueBytes, err := json.Marshal(ej.Value)
-		if err != nil {
-			return err
+// Lefts returns the left values of the given Eithers in order, skipping any Rights.
+func Lefts[L, R any](eithers []Either[L, R]) []L {
+	lefts := make([]L, 0)
+	for _, e := range eithers {
+		if e.isLeft {
+			lefts = append(lefts, e.left)
 		}
-		if err := json.Unmarshal(valueBytes, &e.left); err != nil {
-			return err
-		}
-		e.isLeft = true
-	case "right":
-		valueBytes, err := json.Marshal(ej.Value)
-		if err != nil {
-			return err
+	}
+	return lefts
+}
+
+// Rights returns the right values of the given Eithers in order, skipping any Lefts.
+func Rights[L, R any](eithers []Either[L, R]) []R {
+	rights := make([]R, 0)
+	for _, e := range eithers {
+		if !e.isLeft {
+			rights = append(rights, e.right)
 		}
-		if err := json.Unmarshal(valueBytes, &e.right); err != nil {
-			return err
+	}
+	return rights
+}
+
+// Sequence turns a slice of Eithers into an Either of a slice.
+// If every element is a Right, it returns Right with all right values in order.
+// Otherwise it returns the first Left encountered; later elements are not inspected.
+func Sequence[L, R any](eithers []Either[L, R]) Either[L, []R] {
+	rights := make([]R, 0, len(eithers))
+	for _, e := range eithers {
+		if e.isLeft {
+			return Left[L, []R](e.left)
 		}
-		e.isLeft = false
-	default:
-		return fmt.Errorf("invalid either type: %s (expected 'left' or 'right')", ej.Type)
+		rights = append(rights, e.right)
 	}
+	return Right[L, []R](rights)
+}
 
-	return nil
+// FirstRight calls the candidates in order and returns the first Right, without calling
+// the remaining candidates. If every candidate returns a Left, it returns Left with all
+// left values in order, so the caller sees why each attempt failed.
+// It suits fallback chains such as primary, replica and cached data sources.
+func FirstRight[L, R any](candidates ...func() Either[L, R]) Either[[]L, R] {
+	lefts := make([]L, 0, len(candidates))
+	for _, candidate := range candidates {
+		e := candidate()
+		if !e.isLeft {
+			return Right[[]L](e.right)
+		}
+		lefts = append(lefts, e.left)
+	}
+	return Left[[]L, R](lefts)
 }
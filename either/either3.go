@@ -0,0 +1,192 @@
+package either
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type arm uint8
+
+const (
+	armFirst arm = iota
+	armSecond
+	armThird
+)
+
+// Either3 represents a value of one of three possible types.
+// It extends Either to three-way unions, for example success / recoverable warning / fatal error,
+// without nesting Either values.
+type Either3[A, B, C any] struct {
+	arm    arm
+	first  A
+	second B
+	third  C
+}
+
+// First creates an Either3 holding a value of the first type.
+func First[A, B, C any](value A) Either3[A, B, C] {
+	return Either3[A, B, C]{arm: armFirst, first: value}
+}
+
+// Second creates an Either3 holding a value of the second type.
+func Second[A, B, C any](value B) Either3[A, B, C] {
+	return Either3[A, B, C]{arm: armSecond, second: value}
+}
+
+// Third creates an Either3 holding a value of the third type.
+func Third[A, B, C any](value C) Either3[A, B, C] {
+	return Either3[A, B, C]{arm: armThird, third: value}
+}
+
+// IsFirst returns true if this Either3 holds a value of the first type.
+func (e Either3[A, B, C]) IsFirst() bool {
+	return e.arm == armFirst
+}
+
+// IsSecond returns true if this Either3 holds a value of the second type.
+func (e Either3[A, B, C]) IsSecond() bool {
+	return e.arm == armSecond
+}
+
+// IsThird returns true if this Either3 holds a value of the third type.
+func (e Either3[A, B, C]) IsThird() bool {
+	return e.arm == armThird
+}
+
+// GetFirst returns the first value and a boolean indicating if it was present.
+func (e Either3[A, B, C]) GetFirst() (A, bool) {
+	return e.first, e.arm == armFirst
+}
+
+// GetSecond returns the second value and a boolean indicating if it was present.
+func (e Either3[A, B, C]) GetSecond() (B, bool) {
+	return e.second, e.arm == armSecond
+}
+
+// GetThird returns the third value and a boolean indicating if it was present.
+func (e Either3[A, B, C]) GetThird() (C, bool) {
+	return e.third, e.arm == armThird
+}
+
+// MapFirst applies the function to the first value if present, otherwise returns the Either3 unchanged.
+func (e Either3[A, B, C]) MapFirst(fn func(A) A) Either3[A, B, C] {
+	if e.arm != armFirst {
+		return e
+	}
+	return First[A, B, C](fn(e.first))
+}
+
+// MapSecond applies the function to the second value if present, otherwise returns the Either3 unchanged.
+func (e Either3[A, B, C]) MapSecond(fn func(B) B) Either3[A, B, C] {
+	if e.arm != armSecond {
+		return e
+	}
+	return Second[A, B, C](fn(e.second))
+}
+
+// MapThird applies the function to the third value if present, otherwise returns the Either3 unchanged.
+func (e Either3[A, B, C]) MapThird(fn func(C) C) Either3[A, B, C] {
+	if e.arm != armThird {
+		return e
+	}
+	return Third[A, B, C](fn(e.third))
+}
+
+// Map3 transforms all three types using the provided functions.
+// Only the function matching the held value is called.
+func Map3[A, B, C, A2, B2, C2 any](e Either3[A, B, C], firstFn func(A) A2, secondFn func(B) B2, thirdFn func(C) C2) Either3[A2, B2, C2] {
+	switch e.arm {
+	case armFirst:
+		return First[A2, B2, C2](firstFn(e.first))
+	case armSecond:
+		return Second[A2, B2, C2](secondFn(e.second))
+	default:
+		return Third[A2, B2, C2](thirdFn(e.third))
+	}
+}
+
+// Fold3 applies one of three functions depending on which value is held, and returns the result.
+func Fold3[A, B, C, T any](e Either3[A, B, C], firstFn func(A) T, secondFn func(B) T, thirdFn func(C) T) T {
+	switch e.arm {
+	case armFirst:
+		return firstFn(e.first)
+	case armSecond:
+		return secondFn(e.second)
+	default:
+		return thirdFn(e.third)
+	}
+}
+
+// String returns a string representation of the Either3.
+func (e Either3[A, B, C]) String() string {
+	switch e.arm {
+	case armFirst:
+		return fmt.Sprintf("First(%v)", e.first)
+	case armSecond:
+		return fmt.Sprintf("Second(%v)", e.second)
+	default:
+		return fmt.Sprintf("Third(%v)", e.third)
+	}
+}
+
+// either3JSON is used for JSON unmarshaling to read the type information.
+type either3JSON struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON implements json.Marshaler.
+// The Either3 is marshaled as an object with "type" ("first", "second" or "third") and "value" fields.
+func (e Either3[A, B, C]) MarshalJSON() ([]byte, error) {
+	var (
+		tag   string
+		value any
+	)
+	switch e.arm {
+	case armFirst:
+		tag, value = "first", e.first
+	case armSecond:
+		tag, value = "second", e.second
+	default:
+		tag, value = "third", e.third
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(either3JSON{Type: tag, Value: valueBytes})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Expects a JSON object with "type" and "value" fields.
+func (e *Either3[A, B, C]) UnmarshalJSON(data []byte) error {
+	var ej either3JSON
+	if err := json.Unmarshal(data, &ej); err != nil {
+		return err
+	}
+	if len(ej.Value) == 0 {
+		ej.Value = json.RawMessage("null")
+	}
+
+	var decoded Either3[A, B, C]
+	var err error
+	switch ej.Type {
+	case "first":
+		decoded.arm = armFirst
+		err = json.Unmarshal(ej.Value, &decoded.first)
+	case "second":
+		decoded.arm = armSecond
+		err = json.Unmarshal(ej.Value, &decoded.second)
+	case "third":
+		decoded.arm = armThird
+		err = json.Unmarshal(ej.Value, &decoded.third)
+	default:
+		return fmt.Errorf("invalid either3 type: %s (expected 'first', 'second' or 'third')", ej.Type)
+	}
+	if err != nil {
+		return err
+	}
+
+	*e = decoded
+	return nil
+}
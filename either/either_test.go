@@ -0,0 +1,186 @@
+package either
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type user struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type apiError struct {
+	Code int `json:"code"`
+}
+
+type event struct {
+	Type string `json:"type"`
+}
+
+type status int
+
+func init() {
+	RegisterEncoding[int, status](Encoding{TagField: "kind", ValueField: "data", LeftTag: "err", RightTag: "ok"})
+	RegisterEncoding[string, []int](Encoding{Scheme: Untagged})
+	RegisterEncoding[apiError, user](Encoding{Scheme: Inline})
+	RegisterEncoding[apiError, event](Encoding{Scheme: Inline})
+}
+
+// roundTrip marshals e, checks the JSON against expected and decodes it back.
+func roundTrip[L, R any](t *testing.T, e Either[L, R], expected string) Either[L, R] {
+	t.Helper()
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("marshal %v: %v", e, err)
+	}
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+	var decoded Either[L, R]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal %s: %v", data, err)
+	}
+	return decoded
+}
+
+func TestJSONTagged(t *testing.T) {
+	if got := roundTrip(t, Right[string](42), `{"type":"right","value":42}`); got.Right() != 42 || !got.IsRight() {
+		t.Errorf("expected Right(42), got %v", got)
+	}
+	if got := roundTrip(t, Left[string, int]("boom"), `{"type":"left","value":"boom"}`); got.Left() != "boom" || !got.IsLeft() {
+		t.Errorf("expected Left(boom), got %v", got)
+	}
+
+	// Field names match case-insensitively, like the struct tags of encoding/json.
+	var e Either[string, int]
+	if err := json.Unmarshal([]byte(`{"Type":"right","VALUE":7}`), &e); err != nil || e.Right() != 7 {
+		t.Errorf("expected Right(7), got %v, %v", e, err)
+	}
+	if err := json.Unmarshal([]byte(`{"type":"up","value":7}`), &e); err == nil {
+		t.Error("expected an unknown tag to fail")
+	}
+}
+
+func TestJSONTaggedCustomFields(t *testing.T) {
+	if got := roundTrip(t, Right[int](status(2)), `{"kind":"ok","data":2}`); got.Right() != 2 {
+		t.Errorf("expected Right(2), got %v", got)
+	}
+	if got := roundTrip(t, Left[int, status](404), `{"kind":"err","data":404}`); got.Left() != 404 {
+		t.Errorf("expected Left(404), got %v", got)
+	}
+}
+
+func TestJSONUntagged(t *testing.T) {
+	if got := roundTrip(t, Right[string]([]int{1, 2}), `[1,2]`); fmt.Sprint(got.Right()) != "[1 2]" {
+		t.Errorf("expected Right([1 2]), got %v", got)
+	}
+	if got := roundTrip(t, Left[string, []int]("none"), `"none"`); got.Left() != "none" {
+		t.Errorf("expected Left(none), got %v", got)
+	}
+}
+
+func TestJSONInline(t *testing.T) {
+	right := Right[apiError](user{ID: 1, Name: "gopher"})
+	if got := roundTrip(t, right, `{"type":"right","id":1,"name":"gopher"}`); got.Right() != right.Right() {
+		t.Errorf("expected %v, got %v", right, got)
+	}
+	if got := roundTrip(t, Left[apiError, user](apiError{Code: 3}), `{"type":"left","code":3}`); got.Left().Code != 3 {
+		t.Errorf("expected Left({3}), got %v", got)
+	}
+
+	var e Either[apiError, user]
+	if err := json.Unmarshal([]byte(`{"TYPE":"right","id":2}`), &e); err != nil || e.Right().ID != 2 {
+		t.Errorf("expected Right({2}), got %v, %v", e, err)
+	}
+
+	if _, err := json.Marshal(Right[apiError](event{Type: "click"})); err == nil {
+		t.Error("expected a value with its own type field to be rejected")
+	}
+}
+
+func TestEither3(t *testing.T) {
+	values := []Either3[int, string, bool]{First[int, string, bool](1), Second[int, string, bool]("two"), Third[int, string](true)}
+	for _, e := range values {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Either3[int, string, bool]
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded != e {
+			t.Errorf("expected %v after round trip of %s, got %v", e, data, decoded)
+		}
+	}
+
+	describe := func(e Either3[int, string, bool]) string {
+		return Fold3(e, func(a int) string { return fmt.Sprint("int ", a) },
+			func(b string) string { return "string " + b },
+			func(c bool) string { return fmt.Sprint("bool ", c) })
+	}
+	if got := describe(values[1].MapSecond(func(s string) string { return s + "!" })); got != "string two!" {
+		t.Errorf("expected string two!, got %s", got)
+	}
+	if v, ok := values[0].GetSecond(); ok || v != "" {
+		t.Errorf("expected GetSecond of First to fail, got %q, %v", v, ok)
+	}
+}
+
+func TestValidated(t *testing.T) {
+	name := Valid[string]("gopher")
+	age := Invalid[string, int]("age is required")
+	email := Invalid[string, string]("email is invalid")
+
+	profile := ZipValidated(name, age, func(n string, a int) string { return fmt.Sprint(n, a) })
+	profile = ZipValidated(profile, email, func(p, e string) string { return p + e })
+	if fmt.Sprint(profile.Errors()) != "[age is required email is invalid]" {
+		t.Errorf("expected both errors, got %v", profile.Errors())
+	}
+
+	if got := MapValidated(name, func(s string) int { return len(s) }); !got.IsValid() || got.Value() != 6 {
+		t.Errorf("expected Valid(6), got %v", got)
+	}
+	if got := ToValidated(Left[string, int]("bad")); !got.IsInvalid() || fmt.Sprint(got.Errors()) != "[bad]" {
+		t.Errorf("expected Invalid([bad]), got %v", got)
+	}
+}
+
+func TestCollectValidated(t *testing.T) {
+	valid := CollectValidated([]Validated[string, int]{Valid[string](1), Valid[string](2)})
+	if !valid.IsValid() || fmt.Sprint(valid.Value()) != "[1 2]" {
+		t.Errorf("expected Valid([1 2]), got %v", valid)
+	}
+
+	invalid := CollectValidated([]Validated[string, int]{Invalid[string, int]("a"), Valid[string](1), Invalid[string, int]("b")})
+	if fmt.Sprint(invalid.Errors()) != "[a b]" {
+		t.Errorf("expected errors [a b], got %v", invalid)
+	}
+
+	// An invalid element without errors still makes the whole collection invalid.
+	if got := CollectValidated([]Validated[string, int]{Valid[string](1), Invalid[string, int]()}); !got.IsInvalid() {
+		t.Errorf("expected Invalid, got %v", got)
+	}
+}
+
+func TestErr(t *testing.T) {
+	if Right[string](1).Err() != nil {
+		t.Error("expected a Right to have no error")
+	}
+
+	var leftErr *LeftError[string]
+	if err := Left[string, int]("bad").Err(); !errors.As(err, &leftErr) || leftErr.Value != "bad" {
+		t.Errorf("expected a *LeftError holding bad, got %v", err)
+	}
+
+	cause := errors.New("cause")
+	if err := FromError(0, cause).Err(); !errors.Is(err, cause) {
+		t.Errorf("expected the left error to propagate unchanged, got %v", err)
+	}
+	if e := FromError(5, nil); !e.IsRight() || AsError(e) != nil {
+		t.Errorf("expected Right(5), got %v", e)
+	}
+}
@@ -0,0 +1,44 @@
+package either
+
+import "fmt"
+
+// LeftError is the error returned by Err for a Left value that is not itself an error.
+type LeftError[L any] struct {
+	Value L
+}
+
+// Error returns a description of the left value.
+func (e *LeftError[L]) Error() string {
+	return fmt.Sprintf("either: left value %v", e.Value)
+}
+
+// Err returns nil if this Either is a Right.
+// For a Left it returns the left value itself if it implements error, so it propagates
+// unchanged through errors.Is and errors.As chains, and a *LeftError holding it otherwise.
+func (e Either[L, R]) Err() error {
+	if !e.isLeft {
+		return nil
+	}
+	if err, ok := any(e.left).(error); ok {
+		return err
+	}
+	return &LeftError[L]{Value: e.left}
+}
+
+// AsError returns the left error of an Either whose left type is an error, or nil for a Right.
+// Unlike Err, the left type is checked at compile time.
+func AsError[L error, R any](e Either[L, R]) error {
+	if !e.isLeft {
+		return nil
+	}
+	return e.left
+}
+
+// FromError creates an Either from a standard Go (value, error) pair:
+// Left(err) if err is not nil, otherwise Right(value).
+func FromError[R any](value R, err error) Either[error, R] {
+	if err != nil {
+		return Left[error, R](err)
+	}
+	return Right[error](value)
+}
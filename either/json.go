@@ -0,0 +1,225 @@
+package either
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Scheme selects how an Either is laid out in JSON.
+type Scheme int
+
+const (
+	// Tagged encodes an Either as an envelope holding a discriminator and the value:
+	// {"type":"right","value":42}. This is the default scheme.
+	Tagged Scheme = iota
+
+	// Untagged encodes only the contained value, without any discriminator: 42.
+	// On decode, the value is tried as R first and then as L, so it only round-trips
+	// when the JSON shapes of L and R can be told apart.
+	Untagged
+
+	// Inline encodes the discriminator as an extra field of the contained value,
+	// which must marshal to a JSON object: {"type":"right","id":1,"name":"gopher"}.
+	// Marshaling fails if the value already has a field named like the discriminator.
+	Inline
+)
+
+// Encoding describes the JSON layout used for a specific Either[L, R] type.
+// Empty fields fall back to the defaults of the Tagged scheme.
+type Encoding struct {
+	Scheme     Scheme
+	TagField   string // name of the discriminator field, default "type"
+	ValueField string // name of the value field for Tagged, default "value"
+	LeftTag    string // discriminator value for Left, default "left"
+	RightTag   string // discriminator value for Right, default "right"
+}
+
+var defaultEncoding = Encoding{
+	Scheme:     Tagged,
+	TagField:   "type",
+	ValueField: "value",
+	LeftTag:    "left",
+	RightTag:   "right",
+}
+
+var (
+	encodings    sync.Map // reflect.Type -> Encoding
+	hasEncodings atomic.Bool
+)
+
+// RegisterEncoding sets the JSON encoding used by every Either[L, R] with exactly these type parameters.
+// It is typically called once from an init function, before any marshaling happens.
+// Other instantiations keep the default Tagged encoding.
+func RegisterEncoding[L, R any](enc Encoding) {
+	encodings.Store(reflect.TypeFor[Either[L, R]](), enc.withDefaults())
+	hasEncodings.Store(true)
+}
+
+// withDefaults fills empty fields with the default values.
+func (enc Encoding) withDefaults() Encoding {
+	if enc.TagField == "" {
+		enc.TagField = defaultEncoding.TagField
+	}
+	if enc.ValueField == "" {
+		enc.ValueField = defaultEncoding.ValueField
+	}
+	if enc.LeftTag == "" {
+		enc.LeftTag = defaultEncoding.LeftTag
+	}
+	if enc.RightTag == "" {
+		enc.RightTag = defaultEncoding.RightTag
+	}
+	return enc
+}
+
+// encodingFor returns the registered encoding for Either[L, R], or the default one.
+func encodingFor[L, R any]() Encoding {
+	if hasEncodings.Load() {
+		if enc, ok := encodings.Load(reflect.TypeFor[Either[L, R]]()); ok {
+			return enc.(Encoding)
+		}
+	}
+	return defaultEncoding
+}
+
+// MarshalJSON implements json.Marshaler.
+// By default the Either is marshaled as an object with "type" (either "left" or "right") and "value" fields.
+// Use RegisterEncoding to select another layout.
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	enc := encodingFor[L, R]()
+
+	var value any = e.right
+	tag := enc.RightTag
+	if e.isLeft {
+		value = e.left
+		tag = enc.LeftTag
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if enc.Scheme == Untagged {
+		return valueBytes, nil
+	}
+
+	tagField, _ := json.Marshal(enc.TagField)
+	tagValue, _ := json.Marshal(tag)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	buf.Write(tagField)
+	buf.WriteByte(':')
+	buf.Write(tagValue)
+
+	if enc.Scheme == Inline {
+		var fields map[string]json.RawMessage
+		if len(valueBytes) < 2 || valueBytes[0] != '{' || json.Unmarshal(valueBytes, &fields) != nil {
+			return nil, fmt.Errorf("either: inline encoding requires an object value, got %s", valueBytes)
+		}
+		if name, _, ok := lookupField(fields, enc.TagField); ok {
+			return nil, fmt.Errorf("either: inline value already has a %q field, which collides with the %q discriminator", name, enc.TagField)
+		}
+		body := valueBytes[1 : len(valueBytes)-1]
+		if len(body) > 0 {
+			buf.WriteByte(',')
+			buf.Write(body)
+		}
+		buf.WriteByte('}')
+		return buf.Bytes(), nil
+	}
+
+	valueField, _ := json.Marshal(enc.ValueField)
+	buf.WriteByte(',')
+	buf.Write(valueField)
+	buf.WriteByte(':')
+	buf.Write(valueBytes)
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// By default it expects a JSON object with "type" and "value" fields.
+// Use RegisterEncoding to select another layout.
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	enc := encodingFor[L, R]()
+
+	if enc.Scheme == Untagged {
+		var right R
+		if err := json.Unmarshal(data, &right); err == nil {
+			*e = Right[L](right)
+			return nil
+		}
+		var left L
+		if err := json.Unmarshal(data, &left); err != nil {
+			return fmt.Errorf("either: value matches neither the right nor the left type: %w", err)
+		}
+		*e = Left[L, R](left)
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	tagName, tagBytes, _ := lookupField(fields, enc.TagField)
+	var tag string
+	if err := json.Unmarshal(tagBytes, &tag); err != nil {
+		return fmt.Errorf("either: missing or invalid %q field: %w", enc.TagField, err)
+	}
+
+	var valueBytes []byte
+	if enc.Scheme == Inline {
+		delete(fields, tagName)
+		rest, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		valueBytes = rest
+	} else {
+		_, valueBytes, _ = lookupField(fields, enc.ValueField)
+	}
+
+	switch tag {
+	case enc.LeftTag:
+		var left L
+		if len(valueBytes) > 0 {
+			if err := json.Unmarshal(valueBytes, &left); err != nil {
+				return err
+			}
+		}
+		*e = Left[L, R](left)
+	case enc.RightTag:
+		var right R
+		if len(valueBytes) > 0 {
+			if err := json.Unmarshal(valueBytes, &right); err != nil {
+				return err
+			}
+		}
+		*e = Right[L](right)
+	default:
+		return fmt.Errorf("invalid either type: %s (expected '%s' or '%s')", tag, enc.LeftTag, enc.RightTag)
+	}
+
+	return nil
+}
+
+// lookupField returns the field of an object matching name the way encoding/json matches
+// struct fields: an exact match is preferred, otherwise a case-insensitive one is accepted.
+func lookupField(fields map[string]json.RawMessage, name string) (string, json.RawMessage, bool) {
+	if value, ok := fields[name]; ok {
+		return name, value, true
+	}
+	for key, value := range fields {
+		if strings.EqualFold(key, name) {
+			return key, value, true
+		}
+	}
+	return "", nil, false
+}
@@ -0,0 +1,140 @@
+package either
+
+import "fmt"
+
+// Validated represents the outcome of a validation that accumulates every failure
+// instead of stopping at the first one.
+// A Validated is either Valid, holding a value of type A, or Invalid, holding one or more errors of type E.
+//
+// Unlike FlatMap on Either, combining Validated values never short-circuits:
+// all errors from all inputs are kept, which makes it suitable for form and config validation.
+type Validated[E, A any] struct {
+	errs  []E
+	value A
+}
+
+// Valid creates a successful Validated holding the given value.
+func Valid[E, A any](value A) Validated[E, A] {
+	return Validated[E, A]{value: value}
+}
+
+// Invalid creates a failed Validated holding the given errors.
+// Calling Invalid without errors still produces an invalid result with an empty error list.
+func Invalid[E, A any](errs ...E) Validated[E, A] {
+	if errs == nil {
+		errs = make([]E, 0)
+	}
+	return Validated[E, A]{errs: errs}
+}
+
+// ToValidated converts an Either into a Validated.
+// A Left becomes Invalid with a single error, a Right becomes Valid.
+func ToValidated[E, A any](e Either[E, A]) Validated[E, A] {
+	if e.isLeft {
+		return Invalid[E, A](e.left)
+	}
+	return Valid[E](e.right)
+}
+
+// IsValid returns true if the validation succeeded.
+func (v Validated[E, A]) IsValid() bool {
+	return v.errs == nil
+}
+
+// IsInvalid returns true if the validation failed.
+func (v Validated[E, A]) IsInvalid() bool {
+	return v.errs != nil
+}
+
+// Value returns the validated value.
+// Note: This returns the zero value if the Validated is Invalid. Use IsValid() to check first.
+func (v Validated[E, A]) Value() A {
+	return v.value
+}
+
+// Errors returns the accumulated errors, or nil if the Validated is Valid.
+func (v Validated[E, A]) Errors() []E {
+	return v.errs
+}
+
+// ToEither converts the Validated into an Either with all accumulated errors on the left.
+func (v Validated[E, A]) ToEither() Either[[]E, A] {
+	if v.errs != nil {
+		return Left[[]E, A](v.errs)
+	}
+	return Right[[]E](v.value)
+}
+
+// String returns a string representation of the Validated.
+func (v Validated[E, A]) String() string {
+	if v.errs != nil {
+		return fmt.Sprintf("Invalid(%v)", v.errs)
+	}
+	return fmt.Sprintf("Valid(%v)", v.value)
+}
+
+// MapValidated applies the function to the value if valid, otherwise keeps the errors.
+func MapValidated[E, A, B any](v Validated[E, A], fn func(A) B) Validated[E, B] {
+	if v.errs != nil {
+		return Validated[E, B]{errs: v.errs}
+	}
+	return Valid[E](fn(v.value))
+}
+
+// ZipValidated combines two Validated values with fn if both are valid.
+// If either is invalid, the result is invalid and holds the errors of both, in order.
+func ZipValidated[E, A, B, C any](a Validated[E, A], b Validated[E, B], fn func(A, B) C) Validated[E, C] {
+	if a.errs != nil || b.errs != nil {
+		errs := make([]E, 0, len(a.errs)+len(b.errs))
+		errs = append(errs, a.errs...)
+		errs = append(errs, b.errs...)
+		return Validated[E, C]{errs: errs}
+	}
+	return Valid[E](fn(a.value, b.value))
+}
+
+// CollectValidated combines a slice of Validated values into a single Validated of a slice.
+// The result is valid only if every element is valid; otherwise it holds every error from every element.
+func CollectValidated[E, A any](vs []Validated[E, A]) Validated[E, []A] {
+	var errs []E
+	invalid := false
+	values := make([]A, 0, len(vs))
+	for _, v := range vs {
+		if v.errs != nil {
+			invalid = true
+			errs = append(errs, v.errs...)
+			continue
+		}
+		values = append(values, v.value)
+	}
+	if invalid {
+		return Invalid[E, []A](errs...)
+	}
+	return Valid[E](values)
+}
+
+// ValidateAll is the accumulating counterpart of Sequence.
+// It returns Right with all right values if every element is a Right,
+// otherwise Left with every left value, in order.
+func ValidateAll[L, R any](eithers []Either[L, R]) Either[[]L, []R] {
+	lefts, rights := Partition(eithers)
+	if len(lefts) > 0 {
+		return Left[[]L, []R](lefts)
+	}
+	return Right[[]L](rights)
+}
+
+// ZipWith combines two Eithers with fn if both are Right.
+// If both are Left, their left values are merged using combine, so no error is lost.
+// If only one is Left, that left value is returned.
+func ZipWith[L, A, B, C any](a Either[L, A], b Either[L, B], combine func(L, L) L, fn func(A, B) C) Either[L, C] {
+	switch {
+	case a.isLeft && b.isLeft:
+		return Left[L, C](combine(a.left, b.left))
+	case a.isLeft:
+		return Left[L, C](a.left)
+	case b.isLeft:
+		return Left[L, C](b.left)
+	}
+	return Right[L](fn(a.right, b.right))
+}
@@ -0,0 +1,25 @@
+/*
+Package intern provides a thread-safe string interner.
+
+Interning stores a single canonical copy of each distinct string, so that services
+indexing millions of repeated labels or tags into sets and multimaps keep one copy
+of every value instead of one per occurrence.
+
+Canonical strings are copied into large shared arena blocks rather than allocated
+one by one, and never reference the caller's buffer, so interning a substring of a
+large payload does not keep the payload alive.
+
+Example usage:
+
+	p := intern.New()
+
+	a := p.String("region=eu-west-1")
+	b := p.Bytes([]byte("region=eu-west-1")) // no allocation when already interned
+
+	fmt.Println(a == b)  // true
+	fmt.Println(p.Len()) // 1
+
+The pool is backed by a cmap.ConcurrentMap; cmap options such as cmap.WithSeed can be
+passed to New to control key sharding.
+*/
+package intern
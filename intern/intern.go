@@ -0,0 +1,112 @@
+package intern
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/marouanesouiri/stdx/cmap"
+)
+
+// blockSize is the size of each arena block.
+const blockSize = 64 * 1024
+
+// maxArenaString is the largest string copied into an arena block.
+// Larger strings get their own allocation to avoid wasting block space.
+const maxArenaString = blockSize / 8
+
+// Pool deduplicates strings, returning a canonical instance for each distinct value.
+// It is safe for concurrent use.
+type Pool struct {
+	m     cmap.ConcurrentMap[string, string]
+	arena arena
+}
+
+// New creates a new empty Pool.
+// The options are passed to the underlying cmap.ConcurrentMap.
+func New(opts ...cmap.Option[string, string]) *Pool {
+	return &Pool{
+		m: cmap.New(opts...),
+	}
+}
+
+// String returns the canonical instance of s, interning it if it was not seen before.
+func (p *Pool) String(s string) string {
+	if s == "" {
+		return ""
+	}
+	if v, ok := p.lookup(s); ok {
+		return v
+	}
+	c := p.arena.copy(s)
+	v, _ := p.m.GetOrSet(c, c)
+	return v
+}
+
+// Bytes returns the canonical string instance for b, interning it if it was not seen before.
+// Looking up an already interned value does not allocate.
+func (p *Pool) Bytes(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	// The temporary view is only used for the lookup and never stored.
+	if v, ok := p.lookup(unsafe.String(unsafe.SliceData(b), len(b))); ok {
+		return v
+	}
+	c := p.arena.copy(string(b))
+	v, _ := p.m.GetOrSet(c, c)
+	return v
+}
+
+// Contains checks if s has already been interned.
+func (p *Pool) Contains(s string) bool {
+	return p.m.Has(s)
+}
+
+// Len returns the number of distinct strings in the pool.
+func (p *Pool) Len() int {
+	return p.m.Len()
+}
+
+// Clear removes all strings from the pool.
+// Previously returned strings remain valid.
+func (p *Pool) Clear() {
+	p.m.Clear()
+	p.arena.reset()
+}
+
+// lookup returns the canonical instance of s if present.
+func (p *Pool) lookup(s string) (string, bool) {
+	v := p.m.Get(s)
+	return v.Get(), v.IsPresent()
+}
+
+// arena hands out immutable string copies carved from large byte blocks.
+type arena struct {
+	mu    sync.Mutex
+	block []byte
+}
+
+// copy returns a copy of s that does not share memory with s.
+func (a *arena) copy(s string) string {
+	if len(s) > maxArenaString {
+		return string([]byte(s))
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if cap(a.block)-len(a.block) < len(s) {
+		a.block = make([]byte, 0, blockSize)
+	}
+	start := len(a.block)
+	a.block = append(a.block, s...)
+	return unsafe.String(&a.block[start], len(s))
+}
+
+// reset drops the current block so that new strings start a fresh one.
+// Blocks still referenced by interned strings stay alive until those strings are unreachable.
+func (a *arena) reset() {
+	a.mu.Lock()
+	a.block = nil
+	a.mu.Unlock()
+}
@@ -0,0 +1,109 @@
+package intern
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+func TestPoolString(t *testing.T) {
+	p := New()
+
+	a := p.String("hello")
+	b := p.String(string([]byte("hello")))
+
+	if a != b {
+		t.Errorf("expected equal strings, got %q and %q", a, b)
+	}
+	if unsafe.StringData(a) != unsafe.StringData(b) {
+		t.Error("expected the same canonical instance")
+	}
+	if p.Len() != 1 {
+		t.Errorf("expected 1 interned string, got %d", p.Len())
+	}
+}
+
+func TestPoolBytes(t *testing.T) {
+	p := New()
+
+	buf := []byte("tag:go")
+	a := p.Bytes(buf)
+	buf[0] = 'X'
+
+	if a != "tag:go" {
+		t.Errorf("interned string changed with source buffer: %q", a)
+	}
+	if b := p.String("tag:go"); unsafe.StringData(a) != unsafe.StringData(b) {
+		t.Error("expected the same canonical instance for bytes and string")
+	}
+}
+
+func TestPoolEmptyAndLarge(t *testing.T) {
+	p := New()
+
+	if p.String("") != "" || p.Len() != 0 {
+		t.Error("expected empty string to be returned without interning")
+	}
+
+	large := string(make([]byte, maxArenaString+1))
+	if got := p.String(large); got != large {
+		t.Error("large string was not interned correctly")
+	}
+	if !p.Contains(large) {
+		t.Error("expected large string to be interned")
+	}
+}
+
+func TestPoolClear(t *testing.T) {
+	p := New()
+	s := p.String("kept")
+	p.Clear()
+
+	if p.Len() != 0 {
+		t.Errorf("expected empty pool after clear, got %d", p.Len())
+	}
+	if s != "kept" {
+		t.Errorf("previously interned string changed after clear: %q", s)
+	}
+}
+
+func TestPoolConcurrent(t *testing.T) {
+	p := New()
+	var wg sync.WaitGroup
+	results := make([][]string, 8)
+
+	for g := range results {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				results[g] = append(results[g], p.String(fmt.Sprintf("label-%d", i%100)))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if p.Len() != 100 {
+		t.Errorf("expected 100 interned strings, got %d", p.Len())
+	}
+	for g := 1; g < len(results); g++ {
+		for i := range results[g] {
+			if unsafe.StringData(results[g][i]) != unsafe.StringData(results[0][i]) {
+				t.Fatalf("goroutine %d got a non-canonical instance for %q", g, results[g][i])
+			}
+		}
+	}
+}
+
+func BenchmarkPoolBytesHit(b *testing.B) {
+	p := New()
+	key := []byte("service=checkout")
+	p.Bytes(key)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Bytes(key)
+	}
+}
@@ -203,15 +203,66 @@
 //
 // **Memoization:**
 //
-//	func fibonacci(n int) *lazy.Lazy[int] {
+// Memoize caches the results of a function per argument, computing each one once:
+//
+//	var fibonacci func(n int) int
+//	fibonacci = lazy.Memoize(func(n int) int {
 //	    if n <= 1 {
-//	        return lazy.Of(n)
+//	        return n
 //	    }
-//	    return lazy.New(func() int {
-//	        return fibonacci(n-1).Get() + fibonacci(n-2).Get()
-//	    })
+//	    return fibonacci(n-1) + fibonacci(n-2)
+//	})
+//
+//	fibonacci(80) // each n is computed once
+//
+// Bound the cache with options, and use Memoize2 for two-argument functions:
+//
+//	price := lazy.Memoize2(lookupPrice,
+//	    lazy.WithCapacity(10_000),
+//	    lazy.WithTTL(5*time.Minute),
+//	)
+//
+// # Eager Computation (Futures)
+//
+// Use Go to start the computation right away in a new goroutine. Get still blocks
+// until the value is ready, so the Lazy acts as a lightweight typed future:
+//
+//	profile := lazy.Go(func() Profile {
+//	    return fetchProfile(userID)
+//	})
+//
+//	// ... do other work while the profile loads ...
+//
+//	select {
+//	case <-profile.Done():
+//	    render(profile.Get())
+//	case <-time.After(time.Second):
+//	    renderPlaceholder()
+//	}
+//
+//	if p, ok := profile.TryGet(); ok {
+//	    cache(p) // only if already computed, never blocks
+//	}
+//
+// # Suppliers That Can Fail
+//
+// Use NewErr when computing the value can fail. The ErrorPolicy decides whether
+// a failure is remembered or retried on the next access:
+//
+//	db := lazy.NewErr(func() (*sql.DB, error) {
+//	    return connect()
+//	}, lazy.RetryErrors)
+//
+//	conn, err := db.Get() // a failed connect is retried on the next Get
+//	if err != nil {
+//	    return err
 //	}
 //
+//	res := db.Result() // the same outcome as a result.Result[*sql.DB]
+//
+// With lazy.CacheErrors, the supplier runs at most once and its error is returned
+// on every subsequent call.
+//
 // # Performance Benefits
 //
 // **Deferred Execution:**
@@ -2,6 +2,7 @@ package lazy
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 // Lazy represents a value that is computed only once, on first access.
@@ -11,7 +12,10 @@ type Lazy[T any] struct {
 	once     sync.Once
 	supplier func() T
 	value    T
-	computed bool
+	computed bool // set by Of, whose value is known from the start
+	ready    atomic.Bool
+	mu       sync.Mutex
+	done     chan struct{}
 }
 
 // New creates a new Lazy value that will compute its value using the supplier function
@@ -31,31 +35,76 @@ func Of[T any](value T) Lazy[T] {
 	}
 }
 
+// Go creates a Lazy value and immediately starts computing it in a new goroutine.
+// Get still blocks until the computation is done, so the returned Lazy can be used
+// as a lightweight typed future. Use TryGet or Done to check for completion without blocking.
+func Go[T any](supplier func() T) *Lazy[T] {
+	l := &Lazy[T]{
+		supplier: supplier,
+	}
+	go l.Get()
+	return l
+}
+
 // Get forces the computation if not already done and returns the value.
 // This method is thread-safe - if multiple goroutines call Get() concurrently,
 // the supplier function will only execute once.
 func (l *Lazy[T]) Get() T {
-	l.once.Do(func() {
-		if l.supplier != nil {
-			l.value = l.supplier()
-		}
-		l.computed = true
-	})
+	l.once.Do(l.compute)
 	return l.value
 }
 
+// compute runs the supplier and publishes the value. It is only called through l.once.
+func (l *Lazy[T]) compute() {
+	if l.supplier != nil {
+		l.value = l.supplier()
+	}
+	l.markReady()
+}
+
+// TryGet returns the value and true if it has already been computed,
+// otherwise the zero value and false. It never triggers or waits for the computation.
+func (l *Lazy[T]) TryGet() (T, bool) {
+	if l.IsComputed() {
+		return l.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Done returns a channel that is closed once the value has been computed.
+// It does not trigger the computation; combine it with Go, or with a Get in another goroutine.
+func (l *Lazy[T]) Done() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.done == nil {
+		l.done = make(chan struct{})
+		if l.IsComputed() {
+			close(l.done)
+		}
+	}
+	return l.done
+}
+
+// markReady publishes the computed value to TryGet and Done.
+func (l *Lazy[T]) markReady() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.ready.Store(true)
+	if l.done != nil {
+		select {
+		case <-l.done:
+		default:
+			close(l.done)
+		}
+	}
+}
+
 // IsComputed returns true if the value has been computed, false otherwise.
+// It never triggers the computation, and agrees with TryGet and Done.
 // This method is safe to call concurrently with Get().
 func (l *Lazy[T]) IsComputed() bool {
-	isComputed := false
-	l.once.Do(func() {
-		if l.supplier != nil {
-			l.value = l.supplier()
-		}
-		l.computed = true
-		isComputed = true
-	})
-	return !isComputed || l.computed
+	return l.computed || l.ready.Load()
 }
 
 // Map creates a new Lazy value by applying the transformation function to this Lazy value.
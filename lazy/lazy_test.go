@@ -0,0 +1,89 @@
+package lazy
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLazyIsComputed(t *testing.T) {
+	var calls atomic.Int32
+	l := New(func() int {
+		calls.Add(1)
+		return 42
+	})
+
+	if l.IsComputed() {
+		t.Error("expected a new Lazy not to be computed")
+	}
+	if _, ok := l.TryGet(); ok || calls.Load() != 0 {
+		t.Errorf("expected IsComputed and TryGet not to run the supplier, got %d calls", calls.Load())
+	}
+
+	if v := l.Get(); v != 42 {
+		t.Errorf("expected 42, got %d", v)
+	}
+	if !l.IsComputed() {
+		t.Error("expected the Lazy to be computed after Get")
+	}
+	if v, ok := l.TryGet(); !ok || v != 42 {
+		t.Errorf("expected TryGet to return 42, got %d, %v", v, ok)
+	}
+	select {
+	case <-l.Done():
+	default:
+		t.Error("expected Done to be closed after Get")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected the supplier to run once, got %d calls", calls.Load())
+	}
+}
+
+func TestLazyOf(t *testing.T) {
+	l := Of("ready")
+	if !l.IsComputed() {
+		t.Error("expected Of to be computed")
+	}
+	if v, ok := l.TryGet(); !ok || v != "ready" {
+		t.Errorf("expected TryGet to return ready, got %q, %v", v, ok)
+	}
+	select {
+	case <-l.Done():
+	default:
+		t.Error("expected Done to be closed for Of")
+	}
+	if l.Get() != "ready" {
+		t.Errorf("expected Get to return ready, got %q", l.Get())
+	}
+}
+
+func TestGo(t *testing.T) {
+	release := make(chan struct{})
+	l := Go(func() int {
+		<-release
+		return 7
+	})
+
+	done := l.Done()
+	if _, ok := l.TryGet(); ok {
+		t.Error("expected TryGet to fail while the supplier is running")
+	}
+	select {
+	case <-done:
+		t.Error("expected Done to stay open while the supplier is running")
+	default:
+	}
+
+	close(release)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Done to be closed once the supplier returns")
+	}
+	if v, ok := l.TryGet(); !ok || v != 7 {
+		t.Errorf("expected TryGet to return 7, got %d, %v", v, ok)
+	}
+	if l.Get() != 7 || !l.IsComputed() {
+		t.Errorf("expected Get to return 7, got %d", l.Get())
+	}
+}
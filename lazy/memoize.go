@@ -0,0 +1,123 @@
+package lazy
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/marouanesouiri/stdx/cmap"
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// MemoOption configures a memoized function.
+type MemoOption func(*memoConfig)
+
+type memoConfig struct {
+	capacity int
+	ttl      time.Duration
+}
+
+// WithCapacity limits the number of cached results.
+// When the limit is exceeded, an arbitrary cached result is evicted.
+func WithCapacity(n int) MemoOption {
+	return func(c *memoConfig) {
+		c.capacity = n
+	}
+}
+
+// WithTTL makes cached results expire after the given duration.
+// An expired result is recomputed on the next call with the same arguments.
+func WithTTL(d time.Duration) MemoOption {
+	return func(c *memoConfig) {
+		c.ttl = d
+	}
+}
+
+// memoEntry is a cached result, computed at most once per entry.
+type memoEntry[V any] struct {
+	value   Lazy[V]
+	expires time.Time
+}
+
+// Memoize returns a thread-safe function that caches the results of fn per argument.
+// The first call with a given key computes fn(key); concurrent and later calls with the
+// same key wait for and reuse that result. Results are stored in a cmap.ConcurrentMap,
+// so calls with different keys do not contend.
+//
+// fn may call the memoized function recursively with other keys (e.g. fibonacci),
+// but must not call it with its own key, which would deadlock.
+func Memoize[K comparable, V any](fn func(K) V, opts ...MemoOption) func(K) V {
+	var cfg memoConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	cache := cmap.New[K, *memoEntry[V]]()
+	var size atomic.Int64
+
+	valid := func(e *memoEntry[V], now time.Time) bool {
+		return cfg.ttl <= 0 || now.Before(e.expires)
+	}
+
+	return func(key K) V {
+		var now time.Time
+		if cfg.ttl > 0 {
+			now = time.Now()
+		}
+
+		if e, ok := cache.Get(key).Unpack(); ok && valid(e, now) {
+			return e.value.Get()
+		}
+
+		fresh := &memoEntry[V]{
+			value: New(func() V { return fn(key) }),
+		}
+		if cfg.ttl > 0 {
+			fresh.expires = now.Add(cfg.ttl)
+		}
+
+		created := false
+		entry := cache.Compute(key, func(old optional.Option[*memoEntry[V]]) *memoEntry[V] {
+			if e, ok := old.Unpack(); ok && valid(e, now) {
+				return e
+			}
+			created = old.IsAbsent()
+			return fresh
+		})
+
+		if created && cfg.capacity > 0 && size.Add(1) > int64(cfg.capacity) {
+			evictOther(&cache, key, &size)
+		}
+		return entry.value.Get()
+	}
+}
+
+// Memoize2 is like Memoize for functions of two arguments.
+func Memoize2[A, B comparable, V any](fn func(A, B) V, opts ...MemoOption) func(A, B) V {
+	type key struct {
+		a A
+		b B
+	}
+	memoized := Memoize(func(k key) V {
+		return fn(k.a, k.b)
+	}, opts...)
+	return func(a A, b B) V {
+		return memoized(key{a: a, b: b})
+	}
+}
+
+// evictOther removes one arbitrary cached entry other than keep.
+func evictOther[K comparable, V any](cache *cmap.ConcurrentMap[K, *memoEntry[V]], keep K, size *atomic.Int64) {
+	var victim K
+	found := false
+	cache.Range(func(k K, _ *memoEntry[V]) bool {
+		if k == keep {
+			return true
+		}
+		victim = k
+		found = true
+		return false
+	})
+	if found && cache.Remove(victim).IsPresent() {
+		size.Add(-1)
+	}
+}
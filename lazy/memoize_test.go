@@ -0,0 +1,124 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	square := Memoize(func(x int) int {
+		calls++
+		return x * x
+	})
+	for range 3 {
+		if square(4) != 16 || square(5) != 25 {
+			t.Fatal("expected memoized results to match fn")
+		}
+	}
+	if calls != 2 {
+		t.Errorf("expected one call per key, got %d", calls)
+	}
+
+	var fib func(int) int
+	fib = Memoize(func(n int) int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+	if fib(80) != 23416728348467685 {
+		t.Errorf("expected fib(80) = 23416728348467685, got %d", fib(80))
+	}
+}
+
+func TestMemoize2(t *testing.T) {
+	calls := 0
+	add := Memoize2(func(a int, b string) string {
+		calls++
+		return b + string(rune('0'+a))
+	})
+	add(1, "x")
+	add(1, "x")
+	add(2, "x")
+	if add(1, "x") != "x1" || calls != 2 {
+		t.Errorf("expected x1 with 2 calls, got %q with %d calls", add(1, "x"), calls)
+	}
+}
+
+func TestMemoizeCapacity(t *testing.T) {
+	calls := 0
+	identity := Memoize(func(x int) int {
+		calls++
+		return x
+	}, WithCapacity(10))
+
+	for range 2 {
+		for i := range 100 {
+			identity(i)
+		}
+	}
+	// At most 10 results survive the first pass, so at most 10 calls of the second pass are hits.
+	if calls < 190 {
+		t.Errorf("expected at most 10 cached results, got %d calls for 200 lookups", calls)
+	}
+
+	// The most recent key is never the one evicted.
+	before := calls
+	identity(1000)
+	identity(1000)
+	if calls != before+1 {
+		t.Errorf("expected the newest key to stay cached, got %d extra calls", calls-before)
+	}
+}
+
+func TestMemoizeTTL(t *testing.T) {
+	calls := 0
+	now := Memoize(func(string) int {
+		calls++
+		return calls
+	}, WithTTL(20*time.Millisecond))
+
+	if now("k") != 1 || now("k") != 1 {
+		t.Fatal("expected the result to be cached before it expires")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if got := now("k"); got != 2 {
+		t.Errorf("expected the expired result to be recomputed, got %d", got)
+	}
+	if got := now("k"); got != 2 {
+		t.Errorf("expected the recomputed result to be cached, got %d", got)
+	}
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	var calls [8]atomic.Int32
+	slow := Memoize(func(x int) int {
+		calls[x].Add(1)
+		time.Sleep(time.Millisecond)
+		return x * 10
+	}, WithCapacity(8), WithTTL(time.Minute))
+
+	var wg sync.WaitGroup
+	for g := range 32 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				key := (g + i) % 8
+				if got := slow(key); got != key*10 {
+					t.Errorf("expected %d, got %d", key*10, got)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for key := range calls {
+		if n := calls[key].Load(); n != 1 {
+			t.Errorf("expected key %d to be computed once, got %d", key, n)
+		}
+	}
+}
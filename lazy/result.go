@@ -0,0 +1,85 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/marouanesouiri/stdx/result"
+)
+
+// ErrorPolicy controls what a LazyResult does when its supplier fails.
+type ErrorPolicy int
+
+const (
+	// CacheErrors remembers the first outcome, success or failure.
+	// The supplier runs at most once, like a plain Lazy.
+	CacheErrors ErrorPolicy = iota
+
+	// RetryErrors remembers only successful outcomes.
+	// After a failure, the next call to Get runs the supplier again.
+	RetryErrors
+)
+
+// LazyResult represents a value computed on first access by a supplier that can fail.
+// Depending on its ErrorPolicy, a failure is either cached like a value or retried on the next access.
+// Once a value has been computed successfully, it is always returned without calling the supplier again.
+type LazyResult[T any] struct {
+	mu       sync.Mutex
+	done     atomic.Bool
+	supplier func() (T, error)
+	policy   ErrorPolicy
+	value    T
+	err      error
+}
+
+// NewErr creates a new LazyResult that computes its value using the supplier function
+// when first accessed via Get(). The policy decides whether a failure is cached or retried.
+func NewErr[T any](supplier func() (T, error), policy ErrorPolicy) LazyResult[T] {
+	return LazyResult[T]{
+		supplier: supplier,
+		policy:   policy,
+	}
+}
+
+// Get forces the computation if needed and returns the value and error.
+// This method is thread-safe - concurrent callers wait for a single in-flight computation
+// instead of running the supplier in parallel.
+func (l *LazyResult[T]) Get() (T, error) {
+	if l.done.Load() {
+		return l.value, l.err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.done.Load() {
+		return l.value, l.err
+	}
+
+	var value T
+	var err error
+	if l.supplier != nil {
+		value, err = l.supplier()
+	}
+	if err != nil && l.policy == RetryErrors {
+		var zero T
+		return zero, err
+	}
+
+	l.value = value
+	l.err = err
+	l.done.Store(true)
+	return value, err
+}
+
+// Result forces the computation if needed and returns the outcome as a result.Result.
+func (l *LazyResult[T]) Result() result.Result[T] {
+	return result.From(l.Get())
+}
+
+// IsComputed returns true if an outcome has been stored, false otherwise.
+// With RetryErrors, this only becomes true after a successful computation.
+// This method is safe to call concurrently with Get().
+func (l *LazyResult[T]) IsComputed() bool {
+	return l.done.Load()
+}
@@ -0,0 +1,79 @@
+package lazy
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestLazyResultCacheErrors(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	l := NewErr(func() (int, error) {
+		calls++
+		return 0, boom
+	}, CacheErrors)
+
+	if l.IsComputed() {
+		t.Error("expected a new LazyResult not to be computed")
+	}
+	for range 3 {
+		if _, err := l.Get(); !errors.Is(err, boom) {
+			t.Errorf("expected the cached error, got %v", err)
+		}
+	}
+	if calls != 1 || !l.IsComputed() {
+		t.Errorf("expected the failure to be cached after 1 call, got %d calls", calls)
+	}
+	if r := l.Result(); !errors.Is(r.Err(), boom) {
+		t.Errorf("expected Result to hold the cached error, got %v", r)
+	}
+}
+
+func TestLazyResultRetryErrors(t *testing.T) {
+	calls := 0
+	l := NewErr(func() (int, error) {
+		calls++
+		if calls < 3 {
+			return 0, errors.New("not yet")
+		}
+		return 42, nil
+	}, RetryErrors)
+
+	for i := 1; i < 3; i++ {
+		if _, err := l.Get(); err == nil || l.IsComputed() {
+			t.Fatalf("call %d: expected a failure that is not stored, got %v", i, err)
+		}
+	}
+	for range 3 {
+		if v, err := l.Get(); err != nil || v != 42 {
+			t.Errorf("expected 42, got %d, %v", v, err)
+		}
+	}
+	if calls != 3 || !l.IsComputed() {
+		t.Errorf("expected the supplier to stop after its first success, got %d calls", calls)
+	}
+}
+
+func TestLazyResultConcurrent(t *testing.T) {
+	calls := 0
+	l := NewErr(func() (string, error) {
+		calls++
+		return "ready", nil
+	}, RetryErrors)
+
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := l.Get(); err != nil || v != "ready" {
+				t.Errorf("expected ready, got %q, %v", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expected a single in-flight computation, got %d calls", calls)
+	}
+}
@@ -0,0 +1,36 @@
+// Package lockfree provides concurrent data structures that do not use locks.
+//
+// # MPSCQueue
+//
+// MPSCQueue is an unbounded multi-producer single-consumer queue. Any number of goroutines
+// can push without contending on a mutex, while a single goroutine consumes. It suits
+// high-throughput paths such as log writers and event buses, where many goroutines hand
+// work to one background worker:
+//
+//	q := lockfree.New[Event]()
+//
+//	// Producers, from any goroutine
+//	q.Push(ev)
+//
+//	// Consumer, from a single goroutine
+//	for {
+//	    if q.Drain(handle) == 0 {
+//	        time.Sleep(time.Millisecond)
+//	    }
+//	}
+//
+// The consumer never blocks: TryPop and Drain return immediately when the queue is empty,
+// so the consumer decides how to wait, for example by polling or by pairing the queue
+// with a notification channel.
+//
+// # Ordering
+//
+// Elements are returned in the order their producers claimed a slot. Elements pushed by
+// a single goroutine are therefore always returned in the order that goroutine pushed them.
+//
+// # Memory
+//
+// Elements are stored in linked segments of 64 slots. A segment is released once the
+// consumer has moved past it, so memory grows with the number of elements waiting in the
+// queue, not with the number ever pushed.
+package lockfree
@@ -0,0 +1,124 @@
+package lockfree
+
+import "sync/atomic"
+
+// segmentSize is the number of slots in each segment of an MPSCQueue.
+const segmentSize = 64
+
+// slot holds one element. ready is set once the producer has finished writing value.
+type slot[T any] struct {
+	value T
+	ready atomic.Bool
+}
+
+// segment is a fixed-size array of slots, linked to the next segment once it fills up.
+type segment[T any] struct {
+	slots   [segmentSize]slot[T]
+	claimed atomic.Uint64
+	next    atomic.Pointer[segment[T]]
+}
+
+// MPSCQueue is an unbounded multi-producer single-consumer FIFO queue.
+//
+// Elements are stored in linked fixed-size segments. Producers claim a slot with a single
+// atomic increment and never take a lock, so many goroutines can push concurrently without
+// the contention of a mutex or channel. Only one goroutine may consume at a time.
+//
+// The zero value is not usable; create queues with New.
+type MPSCQueue[T any] struct {
+	tail atomic.Pointer[segment[T]]
+
+	// Consumer-only state.
+	head    *segment[T]
+	headIdx int
+}
+
+// New creates an empty MPSCQueue.
+func New[T any]() *MPSCQueue[T] {
+	seg := new(segment[T])
+	q := &MPSCQueue[T]{head: seg}
+	q.tail.Store(seg)
+	return q
+}
+
+// Push appends value to the queue. It is safe to call from any number of goroutines
+// and never blocks on other producers or the consumer.
+func (q *MPSCQueue[T]) Push(value T) {
+	for {
+		seg := q.tail.Load()
+		if i := seg.claimed.Add(1) - 1; i < segmentSize {
+			seg.slots[i].value = value
+			seg.slots[i].ready.Store(true)
+			return
+		}
+
+		// The segment is full: link a new one holding value, or help advance to the one
+		// another producer linked first.
+		next := seg.next.Load()
+		if next == nil {
+			fresh := new(segment[T])
+			fresh.slots[0].value = value
+			fresh.slots[0].ready.Store(true)
+			fresh.claimed.Store(1)
+			if seg.next.CompareAndSwap(nil, fresh) {
+				q.tail.CompareAndSwap(seg, fresh)
+				return
+			}
+			next = seg.next.Load()
+		}
+		q.tail.CompareAndSwap(seg, next)
+	}
+}
+
+// TryPop removes and returns the element at the head of the queue.
+// It returns false if the queue is empty, or if the next element's producer has claimed
+// its slot but not finished writing it; elements are always returned in FIFO order.
+//
+// TryPop must only be called by one goroutine at a time.
+func (q *MPSCQueue[T]) TryPop() (T, bool) {
+	var zero T
+	if q.headIdx == segmentSize {
+		next := q.head.next.Load()
+		if next == nil {
+			return zero, false
+		}
+		q.head = next
+		q.headIdx = 0
+	}
+
+	s := &q.head.slots[q.headIdx]
+	if !s.ready.Load() {
+		return zero, false
+	}
+	value := s.value
+	s.value = zero
+	q.headIdx++
+	return value, true
+}
+
+// Drain removes every element currently available and passes it to fn, in FIFO order.
+// It returns the number of elements removed. Like TryPop, it must only be called by the consumer.
+func (q *MPSCQueue[T]) Drain(fn func(T)) int {
+	n := 0
+	for {
+		value, ok := q.TryPop()
+		if !ok {
+			return n
+		}
+		fn(value)
+		n++
+	}
+}
+
+// Empty reports whether TryPop would currently return false. It must only be called by the consumer.
+func (q *MPSCQueue[T]) Empty() bool {
+	seg, idx := q.head, q.headIdx
+	if idx == segmentSize {
+		seg = seg.next.Load()
+		if seg == nil {
+			return true
+		}
+		idx = 0
+	}
+	return !seg.slots[idx].ready.Load()
+}
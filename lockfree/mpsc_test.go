@@ -0,0 +1,131 @@
+package lockfree
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/marouanesouiri/stdx/blockingqueue"
+)
+
+func TestMPSCQueueFIFO(t *testing.T) {
+	q := New[int]()
+	if _, ok := q.TryPop(); ok {
+		t.Error("Expected empty queue")
+	}
+
+	for i := range 3 * segmentSize {
+		q.Push(i)
+	}
+	for i := range 3 * segmentSize {
+		v, ok := q.TryPop()
+		if !ok || v != i {
+			t.Fatalf("Expected %d, got %d (ok=%v)", i, v, ok)
+		}
+	}
+	if !q.Empty() {
+		t.Error("Expected queue to be empty after popping everything")
+	}
+}
+
+func TestMPSCQueueConcurrentProducers(t *testing.T) {
+	const producers = 8
+	const perProducer = 10000
+
+	type item struct{ producer, seq int }
+	q := New[item]()
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perProducer {
+				q.Push(item{p, i})
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	next := make([]int, producers)
+	received := 0
+	check := func(it item) {
+		if it.seq != next[it.producer] {
+			t.Fatalf("Expected seq %d from producer %d, got %d", next[it.producer], it.producer, it.seq)
+		}
+		next[it.producer]++
+		received++
+	}
+	for {
+		select {
+		case <-done:
+			q.Drain(check)
+			if received != producers*perProducer {
+				t.Errorf("Expected %d items, got %d", producers*perProducer, received)
+			}
+			return
+		default:
+			q.Drain(check)
+		}
+	}
+}
+
+func BenchmarkMPSCQueue(b *testing.B) {
+	q := New[int]()
+	stop := make(chan struct{})
+	consumed := make(chan struct{})
+	go func() {
+		defer close(consumed)
+		for {
+			if q.Drain(func(int) {}) == 0 {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+			}
+		}
+	}()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			q.Push(1)
+		}
+	})
+	b.StopTimer()
+	close(stop)
+	<-consumed
+}
+
+func BenchmarkBlockingQueue(b *testing.B) {
+	q := blockingqueue.New[int](1024)
+	stop := make(chan struct{})
+	consumed := make(chan struct{})
+	go func() {
+		defer close(consumed)
+		for {
+			if _, ok := q.TryPop(); !ok {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+			}
+		}
+	}()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			q.Push(1)
+		}
+	})
+	b.StopTimer()
+	close(stop)
+	<-consumed
+}
@@ -0,0 +1,49 @@
+/*
+Package metrics provides lightweight, allocation-free metrics backed by atomics.
+
+It offers four metric kinds:
+  - Counter: a monotonically increasing count
+  - Gauge: a float64 value that can go up and down (GaugeFunc reads it from a function)
+  - EWMA: an exponentially weighted moving average of an event rate
+  - Timer: count, mean, min and max of observed durations
+
+Counter, Gauge and Timer are usable as zero values, so they can be embedded directly in
+other structures.
+
+Example usage:
+
+	reg := metrics.NewRegistry()
+
+	requests := reg.Counter("http.requests")
+	latency := reg.Timer("http.latency")
+	rate := reg.EWMA("http.rate", 5*time.Second, time.Minute)
+
+	func handle(w http.ResponseWriter, r *http.Request) {
+		defer latency.Start()()
+		requests.Inc()
+		rate.Update(1)
+		// ...
+	}
+
+	// Expose values owned by other structures
+	reg.Register("queue.depth", metrics.GaugeFunc(func() float64 { return float64(q.Len()) }))
+
+# Exposition
+
+A Registry exposes every metric through one hook. Each calls a function for every sample,
+in name order, which makes it easy to forward samples to any monitoring backend:
+
+	reg.Each(func(s metrics.Sample) {
+		statsd.Gauge(s.Name, s.Value)
+	})
+
+WriteText dumps all samples as "name value" lines:
+
+	reg.WriteText(os.Stdout)
+	// http.latency.count 42
+	// http.latency.max 0.0125
+	// ...
+
+Custom types can be exposed by implementing the Metric interface.
+*/
+package metrics
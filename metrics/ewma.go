@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// EWMA tracks an exponentially weighted moving average of an event rate, in events per second.
+//
+// Events are added with Update and folded into the average once per tick interval.
+// Ticks happen lazily on Update and Rate, so no background goroutine is needed;
+// intervals without any call are decayed as idle intervals on the next call.
+type EWMA struct {
+	interval  int64 // nanoseconds
+	alpha     float64
+	uncounted atomic.Int64
+	rate      atomic.Uint64 // float64 bits, events per nanosecond
+	lastTick  atomic.Int64  // unix nanoseconds
+	init      atomic.Bool
+	now       func() time.Time
+}
+
+// NewEWMA creates an EWMA that ticks every interval and averages over window.
+// For example NewEWMA(5*time.Second, time.Minute) behaves like the Unix one-minute load average.
+func NewEWMA(interval, window time.Duration) *EWMA {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	if window < interval {
+		window = interval
+	}
+	e := &EWMA{
+		interval: int64(interval),
+		alpha:    1 - math.Exp(-float64(interval)/float64(window)),
+		now:      time.Now,
+	}
+	e.lastTick.Store(e.now().UnixNano())
+	return e
+}
+
+// Update records n events.
+func (e *EWMA) Update(n int64) {
+	e.tick()
+	e.uncounted.Add(n)
+}
+
+// Rate returns the current moving average rate in events per second.
+func (e *EWMA) Rate() float64 {
+	e.tick()
+	return math.Float64frombits(e.rate.Load()) * float64(time.Second)
+}
+
+// Collect implements Metric.
+func (e *EWMA) Collect(name string, emit func(Sample)) {
+	emit(Sample{Name: name, Value: e.Rate()})
+}
+
+// tick folds pending events into the average for every interval elapsed since the last tick.
+func (e *EWMA) tick() {
+	now := e.now().UnixNano()
+	last := e.lastTick.Load()
+	elapsed := (now - last) / e.interval
+	if elapsed <= 0 {
+		return
+	}
+	if !e.lastTick.CompareAndSwap(last, last+elapsed*e.interval) {
+		return
+	}
+
+	instant := float64(e.uncounted.Swap(0)) / float64(e.interval)
+	rate := math.Float64frombits(e.rate.Load())
+	if e.init.CompareAndSwap(false, true) {
+		rate = instant
+	} else {
+		rate += e.alpha * (instant - rate)
+	}
+	if elapsed > 1 {
+		rate *= math.Pow(1-e.alpha, float64(elapsed-1))
+	}
+	e.rate.Store(math.Float64bits(rate))
+}
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// Sample is a single named value reported by a metric.
+type Sample struct {
+	Name  string
+	Value float64
+}
+
+// Metric is implemented by every value that can be exposed through a Registry.
+// Collect reports the current state of the metric as one or more samples whose
+// names are derived from the registered name.
+type Metric interface {
+	Collect(name string, emit func(Sample))
+}
+
+// Counter is a monotonically increasing integer counter.
+// The zero value is ready to use and all methods are safe for concurrent use.
+type Counter struct {
+	v atomic.Int64
+}
+
+// Inc increments the counter by one.
+func (c *Counter) Inc() {
+	c.v.Add(1)
+}
+
+// Add increments the counter by n.
+func (c *Counter) Add(n int64) {
+	c.v.Add(n)
+}
+
+// Value returns the current count.
+func (c *Counter) Value() int64 {
+	return c.v.Load()
+}
+
+// Collect implements Metric.
+func (c *Counter) Collect(name string, emit func(Sample)) {
+	emit(Sample{Name: name, Value: float64(c.v.Load())})
+}
+
+// Gauge holds a float64 value that can go up and down.
+// The zero value is ready to use and all methods are safe for concurrent use.
+type Gauge struct {
+	bits atomic.Uint64
+}
+
+// Set sets the gauge to v.
+func (g *Gauge) Set(v float64) {
+	g.bits.Store(math.Float64bits(v))
+}
+
+// Add adds delta to the gauge, which may be negative.
+func (g *Gauge) Add(delta float64) {
+	for {
+		old := g.bits.Load()
+		next := math.Float64bits(math.Float64frombits(old) + delta)
+		if g.bits.CompareAndSwap(old, next) {
+			return
+		}
+	}
+}
+
+// Value returns the current value of the gauge.
+func (g *Gauge) Value() float64 {
+	return math.Float64frombits(g.bits.Load())
+}
+
+// Collect implements Metric.
+func (g *Gauge) Collect(name string, emit func(Sample)) {
+	emit(Sample{Name: name, Value: g.Value()})
+}
+
+// GaugeFunc is a gauge whose value is read from a function at collection time.
+// It is the simplest way to expose values owned by other structures, such as a queue depth
+// or the length of a map.
+type GaugeFunc func() float64
+
+// Collect implements Metric.
+func (f GaugeFunc) Collect(name string, emit func(Sample)) {
+	emit(Sample{Name: name, Value: f()})
+}
@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCounter(t *testing.T) {
+	var c Counter
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				c.Inc()
+			}
+		}()
+	}
+	wg.Wait()
+	if c.Value() != 1000 {
+		t.Errorf("expected 1000, got %d", c.Value())
+	}
+}
+
+func TestGauge(t *testing.T) {
+	var g Gauge
+	g.Set(1.5)
+	g.Add(2)
+	g.Add(-0.5)
+	if g.Value() != 3 {
+		t.Errorf("expected 3, got %v", g.Value())
+	}
+}
+
+func TestTimer(t *testing.T) {
+	var tm Timer
+	tm.Observe(10 * time.Millisecond)
+	tm.Observe(30 * time.Millisecond)
+	if tm.Count() != 2 {
+		t.Errorf("expected count 2, got %d", tm.Count())
+	}
+	if tm.Mean() != 20*time.Millisecond {
+		t.Errorf("expected mean 20ms, got %v", tm.Mean())
+	}
+	if tm.Min() != 10*time.Millisecond || tm.Max() != 30*time.Millisecond {
+		t.Errorf("expected min 10ms and max 30ms, got %v and %v", tm.Min(), tm.Max())
+	}
+}
+
+func TestEWMA(t *testing.T) {
+	now := time.Unix(0, 0)
+	e := NewEWMA(time.Second, time.Minute)
+	e.now = func() time.Time { return now }
+	e.lastTick.Store(now.UnixNano())
+
+	e.Update(10)
+	now = now.Add(time.Second)
+	if rate := e.Rate(); rate != 10 {
+		t.Errorf("expected first rate 10/s, got %v", rate)
+	}
+
+	now = now.Add(time.Minute)
+	if rate := e.Rate(); rate >= 10 || rate <= 0 {
+		t.Errorf("expected rate to decay while idle, got %v", rate)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	reg := NewRegistry()
+	reg.Counter("b.count").Add(3)
+	reg.Gauge("a.gauge").Set(1.5)
+	reg.Register("c.func", GaugeFunc(func() float64 { return 7 }))
+
+	if reg.Counter("b.count").Value() != 3 {
+		t.Error("expected Counter to return the registered counter")
+	}
+
+	var sb strings.Builder
+	if err := reg.WriteText(&sb); err != nil {
+		t.Fatal(err)
+	}
+	expected := "a.gauge 1.5\nb.count 3\nc.func 7\n"
+	if sb.String() != expected {
+		t.Errorf("expected %q, got %q", expected, sb.String())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic when reusing a name with another kind")
+		}
+	}()
+	reg.Gauge("b.count")
+}
@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Registry groups named metrics so they can be exposed through a single hook.
+// All methods are safe for concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	metrics map[string]Metric
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]Metric)}
+}
+
+// Default is the registry used by packages that report metrics without being given one.
+var Default = NewRegistry()
+
+// Register adds a metric under name, replacing any metric previously registered with that name.
+func (r *Registry) Register(name string, m Metric) {
+	r.mu.Lock()
+	r.metrics[name] = m
+	r.mu.Unlock()
+}
+
+// Unregister removes the metric registered under name.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	delete(r.metrics, name)
+	r.mu.Unlock()
+}
+
+// Get returns the metric registered under name, or nil if there is none.
+func (r *Registry) Get(name string) Metric {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.metrics[name]
+}
+
+// Counter returns the Counter registered under name, creating it if needed.
+// Panics if another kind of metric is registered under that name.
+func (r *Registry) Counter(name string) *Counter {
+	return getOrCreate(r, name, func() *Counter { return &Counter{} })
+}
+
+// Gauge returns the Gauge registered under name, creating it if needed.
+// Panics if another kind of metric is registered under that name.
+func (r *Registry) Gauge(name string) *Gauge {
+	return getOrCreate(r, name, func() *Gauge { return &Gauge{} })
+}
+
+// Timer returns the Timer registered under name, creating it if needed.
+// Panics if another kind of metric is registered under that name.
+func (r *Registry) Timer(name string) *Timer {
+	return getOrCreate(r, name, func() *Timer { return &Timer{} })
+}
+
+// EWMA returns the EWMA registered under name, creating it with the given interval and window if needed.
+// Panics if another kind of metric is registered under that name.
+func (r *Registry) EWMA(name string, interval, window time.Duration) *EWMA {
+	return getOrCreate(r, name, func() *EWMA { return NewEWMA(interval, window) })
+}
+
+// getOrCreate returns the metric of type M registered under name, registering a new one if needed.
+func getOrCreate[M Metric](r *Registry, name string, create func() M) M {
+	r.mu.RLock()
+	existing, ok := r.metrics[name]
+	r.mu.RUnlock()
+	if !ok {
+		r.mu.Lock()
+		existing, ok = r.metrics[name]
+		if !ok {
+			existing = create()
+			r.metrics[name] = existing
+		}
+		r.mu.Unlock()
+	}
+
+	m, ok := existing.(M)
+	if !ok {
+		panic(fmt.Sprintf("metrics: %q is registered as %T", name, existing))
+	}
+	return m
+}
+
+// Each calls fn for every sample of every registered metric, in name order.
+// It is the exposition hook: forward samples to any monitoring backend from here.
+func (r *Registry) Each(fn func(Sample)) {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.metrics))
+	snapshot := make(map[string]Metric, len(r.metrics))
+	for name, m := range r.metrics {
+		names = append(names, name)
+		snapshot[name] = m
+	}
+	r.mu.RUnlock()
+
+	slices.Sort(names)
+	for _, name := range names {
+		snapshot[name].Collect(name, fn)
+	}
+}
+
+// WriteText writes every sample as a "name value" line, in name order.
+func (r *Registry) WriteText(w io.Writer) error {
+	var err error
+	r.Each(func(s Sample) {
+		if err != nil {
+			return
+		}
+		_, err = io.WriteString(w, s.Name+" "+strconv.FormatFloat(s.Value, 'g', -1, 64)+"\n")
+	})
+	return err
+}
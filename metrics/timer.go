@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// Timer records the duration of operations: how many ran, their total and mean duration,
+// and the fastest and slowest one.
+// The zero value is ready to use and all methods are safe for concurrent use.
+type Timer struct {
+	count atomic.Int64
+	total atomic.Int64
+	min   atomic.Int64
+	max   atomic.Int64
+}
+
+// Observe records a single duration.
+func (t *Timer) Observe(d time.Duration) {
+	n := int64(d)
+	t.count.Add(1)
+	t.total.Add(n)
+	for {
+		cur := t.min.Load()
+		if cur != 0 && cur <= n || t.min.CompareAndSwap(cur, n) {
+			break
+		}
+	}
+	for {
+		cur := t.max.Load()
+		if cur >= n || t.max.CompareAndSwap(cur, n) {
+			break
+		}
+	}
+}
+
+// Start starts timing an operation and returns a function that records its duration when called.
+//
+//	defer timer.Start()()
+func (t *Timer) Start() func() {
+	start := time.Now()
+	return func() {
+		t.Observe(time.Since(start))
+	}
+}
+
+// Time runs fn and records how long it took.
+func (t *Timer) Time(fn func()) {
+	defer t.Start()()
+	fn()
+}
+
+// Count returns the number of recorded durations.
+func (t *Timer) Count() int64 {
+	return t.count.Load()
+}
+
+// Total returns the sum of all recorded durations.
+func (t *Timer) Total() time.Duration {
+	return time.Duration(t.total.Load())
+}
+
+// Mean returns the average recorded duration, or zero if nothing was recorded.
+func (t *Timer) Mean() time.Duration {
+	count := t.count.Load()
+	if count == 0 {
+		return 0
+	}
+	return time.Duration(t.total.Load() / count)
+}
+
+// Min returns the shortest recorded duration.
+func (t *Timer) Min() time.Duration {
+	return time.Duration(t.min.Load())
+}
+
+// Max returns the longest recorded duration.
+func (t *Timer) Max() time.Duration {
+	return time.Duration(t.max.Load())
+}
+
+// Collect implements Metric.
+// It reports name.count, and name.mean, name.min and name.max in seconds.
+func (t *Timer) Collect(name string, emit func(Sample)) {
+	emit(Sample{Name: name + ".count", Value: float64(t.Count())})
+	emit(Sample{Name: name + ".mean", Value: seconds(t.Mean())})
+	emit(Sample{Name: name + ".min", Value: seconds(t.Min())})
+	emit(Sample{Name: name + ".max", Value: seconds(t.Max())})
+}
+
+func seconds(d time.Duration) float64 {
+	return math.Round(d.Seconds()*1e9) / 1e9
+}
@@ -0,0 +1,226 @@
+package mmap
+
+import (
+	"hash/maphash"
+	"sync"
+
+	"github.com/marouanesouiri/stdx/hash"
+)
+
+// concurrentShardCount is the number of shards of a ConcurrentMultimap, as in cmap.
+const concurrentShardCount = 32
+
+// ConcurrentMultimap is a thread-safe Multimap.
+// Keys are spread over shards, each a Multimap guarded by its own lock, so operations
+// on different keys rarely contend. Every operation on a single key is atomic.
+type ConcurrentMultimap[K comparable, V comparable] struct {
+	shards   []*concurrentShard[K, V]
+	hashFunc hash.Hasher[K]
+	seed     maphash.Seed
+}
+
+// concurrentShard is a single ConcurrentMultimap shard with its own lock.
+type concurrentShard[K comparable, V comparable] struct {
+	mu sync.RWMutex
+	m  Multimap[K, V]
+}
+
+// NewConcurrent creates a new empty ConcurrentMultimap.
+// It accepts the same options as New, such as WithMaxValuesPerKey, applied per key.
+func NewConcurrent[K comparable, V comparable](opts ...Option[K, V]) ConcurrentMultimap[K, V] {
+	shards := make([]*concurrentShard[K, V], concurrentShardCount)
+	for i := range shards {
+		shards[i] = &concurrentShard[K, V]{m: New(opts...)}
+	}
+	return ConcurrentMultimap[K, V]{
+		shards:   shards,
+		hashFunc: hash.GetHashFunc[K](),
+		seed:     maphash.MakeSeed(),
+	}
+}
+
+// getShard returns the shard for the given key.
+func (m *ConcurrentMultimap[K, V]) getShard(key K) *concurrentShard[K, V] {
+	return m.shards[m.hashFunc(m.seed, key)&(concurrentShardCount-1)]
+}
+
+// Put adds a value to the set of values for a key.
+// Returns true if the value was added, false if it already existed
+// or was rejected because the key reached its value cap.
+func (m *ConcurrentMultimap[K, V]) Put(key K, value V) bool {
+	shard := m.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	return shard.m.Put(key, value)
+}
+
+// PutAll adds multiple values for a key atomically: no reader sees only some of them.
+// Returns the number of values that were actually added.
+func (m *ConcurrentMultimap[K, V]) PutAll(key K, values ...V) int {
+	shard := m.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	return shard.m.PutAll(key, values...)
+}
+
+// Get returns a snapshot of the values associated with a key.
+// Returns an empty slice if the key doesn't exist.
+// The slice is a copy and is not affected by later changes.
+func (m *ConcurrentMultimap[K, V]) Get(key K) []V {
+	shard := m.getShard(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return shard.m.Get(key)
+}
+
+// Delete removes a specific value for a key.
+// Returns true if the value was present and removed, false otherwise.
+func (m *ConcurrentMultimap[K, V]) Delete(key K, value V) bool {
+	shard := m.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	return shard.m.Delete(key, value)
+}
+
+// DeleteAll removes all values for a key.
+// Returns true if the key existed, false otherwise.
+func (m *ConcurrentMultimap[K, V]) DeleteAll(key K) bool {
+	shard := m.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	return shard.m.DeleteAll(key)
+}
+
+// Replace atomically replaces all values of a key with values and returns the previous ones.
+// Replacing with no values removes the key. The cap set by WithMaxValuesPerKey still applies.
+func (m *ConcurrentMultimap[K, V]) Replace(key K, values ...V) []V {
+	shard := m.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	old := shard.m.Get(key)
+	shard.m.DeleteAll(key)
+	shard.m.PutAll(key, values...)
+	return old
+}
+
+// Contains checks if a specific key-value pair exists.
+func (m *ConcurrentMultimap[K, V]) Contains(key K, value V) bool {
+	shard := m.getShard(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return shard.m.Contains(key, value)
+}
+
+// ContainsKey checks if a key exists in the multimap.
+func (m *ConcurrentMultimap[K, V]) ContainsKey(key K) bool {
+	shard := m.getShard(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return shard.m.ContainsKey(key)
+}
+
+// KeySize returns the number of values for a specific key.
+func (m *ConcurrentMultimap[K, V]) KeySize(key K) int {
+	shard := m.getShard(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return shard.m.KeySize(key)
+}
+
+// Size returns the total number of key-value pairs.
+// Shards are counted one after another, so under concurrent writes the result may not
+// correspond to any single point in time; use Snapshot for a consistent view.
+func (m *ConcurrentMultimap[K, V]) Size() int {
+	count := 0
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+		count += shard.m.Size()
+		shard.mu.RUnlock()
+	}
+	return count
+}
+
+// Len returns the number of unique keys, with the same consistency as Size.
+func (m *ConcurrentMultimap[K, V]) Len() int {
+	count := 0
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+		count += shard.m.Len()
+		shard.mu.RUnlock()
+	}
+	return count
+}
+
+// Clear removes all key-value pairs from the multimap.
+func (m *ConcurrentMultimap[K, V]) Clear() {
+	for _, shard := range m.shards {
+		shard.mu.Lock()
+		shard.m.Clear()
+		shard.mu.Unlock()
+	}
+}
+
+// Keys returns a slice of all unique keys.
+func (m *ConcurrentMultimap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+		keys = append(keys, shard.m.Keys()...)
+		shard.mu.RUnlock()
+	}
+	return keys
+}
+
+// Range iterates over all key-value pairs.
+// If the function returns false, iteration stops.
+// Note: The function is called while holding a read lock on each shard,
+// so it must not modify the multimap.
+func (m *ConcurrentMultimap[K, V]) Range(fn func(K, V) bool) {
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+		stopped := false
+		shard.m.Range(func(k K, v V) bool {
+			stopped = !fn(k, v)
+			return !stopped
+		})
+		shard.mu.RUnlock()
+		if stopped {
+			return
+		}
+	}
+}
+
+// Snapshot returns a copy of the whole multimap as a plain Multimap, taken at a single
+// point in time: all shards are locked while copying, so no write is half visible.
+// The copy keeps the cap set by WithMaxValuesPerKey and is independent of the original.
+func (m *ConcurrentMultimap[K, V]) Snapshot() Multimap[K, V] {
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+	}
+	defer func() {
+		for _, shard := range m.shards {
+			shard.mu.RUnlock()
+		}
+	}()
+
+	snapshot := m.shards[0].m
+	snapshot.items = make(map[K]map[V]struct{})
+	snapshot.size = 0
+	for _, shard := range m.shards {
+		for k, set := range shard.m.items {
+			values := make(map[V]struct{}, len(set))
+			for v := range set {
+				values[v] = struct{}{}
+			}
+			snapshot.items[k] = values
+			snapshot.size += len(set)
+		}
+	}
+	return snapshot
+}
+
+// String returns a string representation of the ConcurrentMultimap.
+func (m *ConcurrentMultimap[K, V]) String() string {
+	snapshot := m.Snapshot()
+	return "Concurrent" + snapshot.String()
+}
@@ -0,0 +1,76 @@
+package mmap
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrentMultimap(t *testing.T) {
+	m := NewConcurrent[int, int]()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				m.Put(i%50, g*1000+i)
+				if i%4 == 0 {
+					m.Delete(i%50, g*1000+i)
+				}
+				m.Get(i % 50)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if m.Len() != 50 {
+		t.Errorf("Expected 50 keys, got %d", m.Len())
+	}
+	if m.Size() != 6000 {
+		t.Errorf("Expected 6000 values, got %d", m.Size())
+	}
+
+	snapshot := m.Snapshot()
+	if snapshot.Size() != m.Size() || snapshot.Len() != 50 {
+		t.Errorf("Expected snapshot to match, got size %d and %d keys", snapshot.Size(), snapshot.Len())
+	}
+	m.Clear()
+	if snapshot.Size() != 6000 || m.Size() != 0 {
+		t.Error("Expected snapshot to be independent of the multimap")
+	}
+}
+
+func TestConcurrentMultimapOperations(t *testing.T) {
+	m := NewConcurrent(WithMaxValuesPerKey[string, int](2, RejectNew))
+
+	if m.PutAll("a", 1, 2, 3) != 2 {
+		t.Error("Expected the cap to reject the third value")
+	}
+	if !m.Contains("a", 1) || m.Contains("a", 3) || m.KeySize("a") != 2 {
+		t.Errorf("Unexpected values for a: %v", m.Get("a"))
+	}
+
+	old := m.Replace("a", 7)
+	if len(old) != 2 || m.KeySize("a") != 1 || !m.Contains("a", 7) {
+		t.Errorf("Expected Replace to swap values, got old %v and new %v", old, m.Get("a"))
+	}
+	m.Replace("a")
+	if m.ContainsKey("a") {
+		t.Error("Expected Replace with no values to remove the key")
+	}
+
+	m.Put("b", 1)
+	m.Put("c", 1)
+	if !m.DeleteAll("b") || m.DeleteAll("b") {
+		t.Error("Expected DeleteAll to remove b exactly once")
+	}
+	count := 0
+	m.Range(func(string, int) bool {
+		count++
+		return false
+	})
+	if count != 1 || len(m.Keys()) != 1 {
+		t.Errorf("Expected one key left and Range to stop early, got %d calls and keys %v", count, m.Keys())
+	}
+}
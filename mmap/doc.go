@@ -25,6 +25,17 @@
 //
 //	count := m.PutAll("more", 3, 4, 5) // Adds 3 values, returns 3
 //
+// # Capping Values per Key
+//
+// Long-running indexes can be protected from hot keys by capping the number of values per key:
+//
+//	m := mmap.New(mmap.WithMaxValuesPerKey[string, int](2, mmap.RejectNew))
+//	m.Put("k", 1) // true
+//	m.Put("k", 2) // true
+//	m.Put("k", 3) // false (key is full)
+//
+// With EvictArbitrary, the new value replaces an arbitrary existing one instead.
+//
 // # Retrieving Values
 //
 //	m := mmap.New[string, string]()
@@ -180,10 +191,9 @@
 //   - Grouping related items
 //   - Preventing duplicate values per key
 //
-// Use map[K][]V when:
+// Use ListMultimap when:
 //   - Need to preserve value order
 //   - Allow duplicate values
-//   - Values are accessed as a whole slice
 //
 // # No Duplicates
 //
@@ -196,20 +206,40 @@
 //
 //	values := m.Get("nums") // [1, 2] (no duplicate 1)
 //
+// # Ordered Values and Duplicates
+//
+// ListMultimap stores the values of each key in a list instead, like Guava's ArrayListMultimap:
+// values keep their insertion order and duplicates are kept:
+//
+//	events := mmap.NewList[string, string]()
+//	events.Put("order-1", "created")
+//	events.Put("order-1", "paid")
+//	events.Put("order-1", "paid")
+//
+//	history := events.Get("order-1") // [created paid paid]
+//	events.Delete("order-1", "paid") // removes the first "paid"
+//
+// Put and DeleteAll are O(1); Get copies the values of the key, and Delete and Contains scan them.
+//
 // # Thread Safety
 //
-// Multimap is not thread-safe. For concurrent access, use external synchronization.
+// Multimap is not thread-safe. For concurrent access, use ConcurrentMultimap, which shards
+// keys like cmap.ConcurrentMap so that goroutines working on different keys rarely contend:
 //
-//	var mu sync.RWMutex
-//	m := mmap.New[string, int]()
+//	subscribers := mmap.NewConcurrent[string, ConnID]()
+//
+//	// From any goroutine
+//	subscribers.Put("news", conn)
+//	ids := subscribers.Get("news") // a copy, safe to use while others write
+//
+//	// Atomically swap all values of a key
+//	previous := subscribers.Replace("news", conn1, conn2)
 //
-//	mu.Lock()
-//	m.Put(key, value)
-//	mu.Unlock()
+//	// A consistent copy of everything, as a plain Multimap
+//	all := subscribers.Snapshot()
 //
-//	mu.RLock()
-//	values := m.Get(key)
-//	mu.RUnlock()
+// Each operation on a single key is atomic. Size, Len, Keys and Range visit shards one after
+// another; Snapshot locks all shards at once when a point-in-time view is needed.
 //
 // # Value Type Constraints
 //
@@ -0,0 +1,179 @@
+package mmap
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ListMultimap is a map that allows multiple values per key, stored in a list per key.
+// Unlike Multimap, it keeps the values of each key in insertion order and allows duplicates.
+type ListMultimap[K comparable, V comparable] struct {
+	items map[K][]V
+	size  int
+}
+
+// NewList creates and returns a new empty ListMultimap.
+func NewList[K comparable, V comparable]() ListMultimap[K, V] {
+	return ListMultimap[K, V]{
+		items: make(map[K][]V),
+	}
+}
+
+// Put appends a value to the values of a key, even if the key already holds an equal value.
+func (m *ListMultimap[K, V]) Put(key K, value V) {
+	m.items[key] = append(m.items[key], value)
+	m.size++
+}
+
+// PutAll appends multiple values to the values of a key, in order.
+func (m *ListMultimap[K, V]) PutAll(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	m.items[key] = append(m.items[key], values...)
+	m.size += len(values)
+}
+
+// Get returns all values associated with a key, in insertion order.
+// Returns an empty slice if the key doesn't exist.
+// The slice is a copy and may be modified by the caller.
+func (m *ListMultimap[K, V]) Get(key K) []V {
+	list, exists := m.items[key]
+	if !exists {
+		return []V{}
+	}
+	return slices.Clone(list)
+}
+
+// Delete removes the first occurrence of a value for a key.
+// Returns true if the value was present and removed, false otherwise.
+func (m *ListMultimap[K, V]) Delete(key K, value V) bool {
+	list := m.items[key]
+	i := slices.Index(list, value)
+	if i < 0 {
+		return false
+	}
+
+	m.size--
+	if len(list) == 1 {
+		delete(m.items, key)
+		return true
+	}
+	m.items[key] = slices.Delete(list, i, i+1)
+	return true
+}
+
+// DeleteAll removes all values for a key.
+// Returns true if the key existed, false otherwise.
+func (m *ListMultimap[K, V]) DeleteAll(key K) bool {
+	list, exists := m.items[key]
+	if !exists {
+		return false
+	}
+
+	m.size -= len(list)
+	delete(m.items, key)
+	return true
+}
+
+// Contains checks if a specific key-value pair exists.
+func (m *ListMultimap[K, V]) Contains(key K, value V) bool {
+	return slices.Contains(m.items[key], value)
+}
+
+// ContainsKey checks if a key exists in the multimap.
+func (m *ListMultimap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.items[key]
+	return exists
+}
+
+// Size returns the total number of values across all keys, counting duplicates.
+func (m *ListMultimap[K, V]) Size() int {
+	return m.size
+}
+
+// KeySize returns the number of values for a specific key, counting duplicates.
+func (m *ListMultimap[K, V]) KeySize(key K) int {
+	return len(m.items[key])
+}
+
+// Len returns the number of unique keys.
+func (m *ListMultimap[K, V]) Len() int {
+	return len(m.items)
+}
+
+// Clear removes all key-value pairs from the multimap.
+func (m *ListMultimap[K, V]) Clear() {
+	m.items = make(map[K][]V)
+	m.size = 0
+}
+
+// Keys returns a slice of all unique keys, in unspecified order.
+func (m *ListMultimap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.items))
+	for k := range m.items {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns a slice of all values across all keys.
+// Keys are visited in unspecified order; the values of each key are in insertion order.
+func (m *ListMultimap[K, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	for _, list := range m.items {
+		values = append(values, list...)
+	}
+	return values
+}
+
+// Entries returns all key-value pairs as a slice, one per value.
+// Keys are visited in unspecified order; the values of each key are in insertion order.
+func (m *ListMultimap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.size)
+	for k, list := range m.items {
+		for _, v := range list {
+			entries = append(entries, Entry[K, V]{Key: k, Value: v})
+		}
+	}
+	return entries
+}
+
+// Range iterates over all key-value pairs, with the values of each key in insertion order.
+// If the function returns false, iteration stops.
+func (m *ListMultimap[K, V]) Range(fn func(K, V) bool) {
+	for k, list := range m.items {
+		for _, v := range list {
+			if !fn(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// ForEachKey iterates over keys with their associated values in insertion order.
+// If the function returns false, iteration stops.
+func (m *ListMultimap[K, V]) ForEachKey(fn func(K, []V) bool) {
+	for k := range m.items {
+		if !fn(k, m.Get(k)) {
+			return
+		}
+	}
+}
+
+// String returns a string representation of the ListMultimap.
+func (m *ListMultimap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("ListMultimap{")
+	first := true
+	for k, list := range m.items {
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		fmt.Fprintf(&sb, "%v:%v", k, list)
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
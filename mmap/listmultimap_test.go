@@ -0,0 +1,79 @@
+package mmap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestListMultimapOrderAndDuplicates(t *testing.T) {
+	m := NewList[string, int]()
+	m.Put("a", 3)
+	m.Put("a", 1)
+	m.Put("a", 3)
+	m.PutAll("a", 2, 1)
+	m.Put("b", 9)
+
+	if values := m.Get("a"); !slices.Equal(values, []int{3, 1, 3, 2, 1}) {
+		t.Errorf("Expected [3 1 3 2 1], got %v", values)
+	}
+	if m.Size() != 6 || m.Len() != 2 || m.KeySize("a") != 5 {
+		t.Errorf("Expected size 6, 2 keys and 5 values for a, got %d, %d, %d", m.Size(), m.Len(), m.KeySize("a"))
+	}
+
+	values := m.Get("a")
+	values[0] = 100
+	if m.Get("a")[0] != 3 {
+		t.Error("Expected Get to return a copy")
+	}
+}
+
+func TestListMultimapDelete(t *testing.T) {
+	m := NewList[string, int]()
+	m.PutAll("a", 1, 2, 1)
+
+	if !m.Delete("a", 1) {
+		t.Error("Expected Delete to remove the first 1")
+	}
+	if values := m.Get("a"); !slices.Equal(values, []int{2, 1}) {
+		t.Errorf("Expected [2 1], got %v", values)
+	}
+	if m.Delete("a", 5) || m.Delete("missing", 1) {
+		t.Error("Expected Delete of a missing value to return false")
+	}
+	if !m.Contains("a", 1) || m.Contains("a", 5) {
+		t.Error("Unexpected Contains result")
+	}
+
+	m.Delete("a", 2)
+	m.Delete("a", 1)
+	if m.ContainsKey("a") || m.Size() != 0 {
+		t.Errorf("Expected a to be removed with its last value, got %v", m.String())
+	}
+
+	m.PutAll("b", 1, 1)
+	if !m.DeleteAll("b") || m.DeleteAll("b") || m.Size() != 0 {
+		t.Error("Expected DeleteAll to remove b exactly once")
+	}
+}
+
+func TestListMultimapIteration(t *testing.T) {
+	m := NewList[string, int]()
+	m.PutAll("a", 1, 1, 2)
+	m.PutAll("b", 3)
+
+	count := 0
+	m.Range(func(string, int) bool {
+		count++
+		return true
+	})
+	if count != 4 || len(m.Values()) != 4 || len(m.Entries()) != 4 {
+		t.Errorf("Expected 4 pairs, got %d", count)
+	}
+
+	m.ForEachKey(func(k string, values []int) bool {
+		if k == "a" && !slices.Equal(values, []int{1, 1, 2}) {
+			t.Errorf("Expected [1 1 2] for a, got %v", values)
+		}
+		return true
+	})
+}
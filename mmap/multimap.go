@@ -8,8 +8,10 @@ import (
 // Multimap is a map that allows multiple values per key.
 // It prevents duplicate values for the same key.
 type Multimap[K comparable, V comparable] struct {
-	items map[K]map[V]struct{}
-	size  int
+	items     map[K]map[V]struct{}
+	size      int
+	maxValues int
+	capPolicy CapPolicy
 }
 
 // Entry represents a single key-value pair from the multimap.
@@ -18,25 +20,67 @@ type Entry[K comparable, V comparable] struct {
 	Value V
 }
 
+// CapPolicy decides what Put does when a key already holds the maximum number of values.
+type CapPolicy int
+
+const (
+	// RejectNew leaves the existing values in place and drops the new one.
+	RejectNew CapPolicy = iota
+
+	// EvictArbitrary removes an arbitrary existing value of the key to make room for the new one.
+	EvictArbitrary
+)
+
+// Option defines a functional option for Multimap configuration.
+type Option[K comparable, V comparable] func(Multimap[K, V]) Multimap[K, V]
+
+// WithMaxValuesPerKey caps the number of values stored per key to n.
+// When a key is full, policy decides whether the new value is rejected or replaces an arbitrary one.
+// A non-positive n disables the cap.
+func WithMaxValuesPerKey[K comparable, V comparable](n int, policy CapPolicy) Option[K, V] {
+	return func(m Multimap[K, V]) Multimap[K, V] {
+		m.maxValues = n
+		m.capPolicy = policy
+		return m
+	}
+}
+
 // New creates and returns a new empty Multimap.
-func New[K comparable, V comparable]() Multimap[K, V] {
-	return Multimap[K, V]{
+func New[K comparable, V comparable](opts ...Option[K, V]) Multimap[K, V] {
+	m := Multimap[K, V]{
 		items: make(map[K]map[V]struct{}),
 	}
+	for _, opt := range opts {
+		m = opt(m)
+	}
+	return m
 }
 
 // Put adds a value to the set of values for a key.
-// Returns true if the value was added, false if it already existed.
+// Returns true if the value was added, false if it already existed
+// or was rejected because the key reached its value cap.
 func (m *Multimap[K, V]) Put(key K, value V) bool {
 	if m.items[key] == nil {
 		m.items[key] = make(map[V]struct{})
 	}
 
-	if _, exists := m.items[key][value]; exists {
+	set := m.items[key]
+	if _, exists := set[value]; exists {
 		return false
 	}
 
-	m.items[key][value] = struct{}{}
+	if m.maxValues > 0 && len(set) >= m.maxValues {
+		if m.capPolicy == RejectNew {
+			return false
+		}
+		for v := range set {
+			delete(set, v)
+			m.size--
+			break
+		}
+	}
+
+	set[value] = struct{}{}
 	m.size++
 	return true
 }
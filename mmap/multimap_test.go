@@ -153,3 +153,26 @@ func TestMultimapForEachKey(t *testing.T) {
 		t.Errorf("Expected 2 keys, got %d", keyCount)
 	}
 }
+
+func TestMultimapMaxValuesPerKey(t *testing.T) {
+	m := New(WithMaxValuesPerKey[string, int](2, RejectNew))
+	m.PutAll("key", 1, 2)
+	if m.Put("key", 3) {
+		t.Error("Expected Put to return false when the key is full")
+	}
+	if m.KeySize("key") != 2 || m.Contains("key", 3) {
+		t.Errorf("Expected key to keep its 2 original values, got %v", m.Get("key"))
+	}
+
+	e := New(WithMaxValuesPerKey[string, int](2, EvictArbitrary))
+	e.PutAll("key", 1, 2)
+	if !e.Put("key", 3) {
+		t.Error("Expected Put to evict and add the new value")
+	}
+	if e.KeySize("key") != 2 || !e.Contains("key", 3) {
+		t.Errorf("Expected 2 values including 3, got %v", e.Get("key"))
+	}
+	if e.Size() != 2 {
+		t.Errorf("Expected size 2, got %d", e.Size())
+	}
+}
@@ -1,4 +1,5 @@
-// Package omap provides an ordered map that maintains insertion order.
+// Package omap provides an ordered map that maintains insertion order,
+// and a sorted map that keeps its keys sorted.
 //
 // OrderedMap is a map implementation that preserves the order in which keys were inserted.
 // Unlike Go's built-in map, iteration over an OrderedMap is predictable and follows insertion order.
@@ -28,6 +29,16 @@
 //
 //	keys := m.Keys() // ["b", "a"]
 //
+// Use WithStableOrder to keep keys at the position where they were first inserted,
+// e.g. when editing a config file without reordering it:
+//
+//	m := omap.New(omap.WithStableOrder[string, int]())
+//	m.Set("a", 1)
+//	m.Set("b", 2)
+//	m.Set("a", 10) // Updates value in place
+//
+//	keys := m.Keys() // ["a", "b"]
+//
 // # Basic Operations
 //
 //	m := omap.New[string, int]()
@@ -52,6 +63,16 @@
 //	    return true // Continue iteration
 //	})
 //
+// Or use range-over-func, forwards or backwards, without copying the entries:
+//
+//	for key, value := range m.Seq() {
+//	    fmt.Printf("%s=%d ", key, value)
+//	}
+//
+//	for key, value := range m.ReverseSeq() {
+//	    fmt.Printf("%s=%d ", key, value) // m=3 a=2 z=1
+//	}
+//
 // Get ordered slices:
 //
 //	keys := m.Keys()     // ["z", "a", "m"] in insertion order
@@ -70,6 +91,22 @@
 //	key, val, ok := m.First() // "first", 1, true
 //	key, val, ok = m.Last()   // "third", 3, true
 //
+// # Positional Insertion
+//
+// Place keys relative to existing ones, or at a given position:
+//
+//	chain := omap.New[string, Middleware]()
+//	chain.Set("auth", auth)
+//	chain.Set("handler", handler)
+//
+//	chain.SetBefore("handler", "gzip", gzip) // auth, gzip, handler
+//	chain.SetAfter("auth", "log", log)       // auth, log, gzip, handler
+//	chain.MoveToFront("log")                 // log, auth, gzip, handler
+//	chain.InsertAt(1, "cors", cors)          // log, cors, auth, gzip, handler
+//
+//	i := chain.IndexOf("gzip")  // 3
+//	item := chain.GetAt(0)      // Some({log, log})
+//
 // # Pop Operations
 //
 // Remove and return first or last entries:
@@ -82,6 +119,26 @@
 //	key, val, ok := m.PopFirst() // "a", 1, true
 //	key, val, ok = m.PopLast()   // "c", 3, true
 //
+// # Sorted Maps
+//
+// SortedMap keeps keys in sorted order instead of insertion order, in an AVL tree.
+// Besides lookups, it answers nearest-key and key-range queries, e.g. for time-indexed history:
+//
+//	history := omap.NewSortedFunc[time.Time, Event](time.Time.Compare)
+//	history.Set(e.At, e)
+//
+//	// The state at a given time is the last event at or before it
+//	latest := history.Floor(t)
+//
+//	// All events of the last hour
+//	for at, e := range history.Between(now.Add(-time.Hour), now) {
+//	    fmt.Println(at, e)
+//	}
+//
+// Use NewSorted for keys with a natural order (numbers, strings), and NewSortedFunc for
+// anything else. Get, Set, Delete, Floor and Ceiling are O(log n); Between is O(log n + k)
+// for k matching keys.
+//
 // # Use Cases
 //
 // **LRU Cache:**
@@ -135,8 +192,10 @@
 //   - Delete: O(1)
 //   - First/Last: O(1)
 //   - PopFirst/PopLast: O(1)
+//   - SetBefore/SetAfter/MoveToFront/MoveToBack: O(1)
+//   - InsertAt/GetAt/IndexOf: O(n)
 //   - Keys/Values/Items: O(n)
-//   - Range: O(n)
+//   - Range/ReverseRange/Seq/ReverseSeq: O(n)
 //
 // **Space Complexity:**
 //   - O(n) where n is the number of entries
@@ -2,6 +2,7 @@ package omap
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/marouanesouiri/stdx/optional"
@@ -14,6 +15,8 @@ type OrderedMap[K comparable, V any] struct {
 	head  *entry[K, V]
 	tail  *entry[K, V]
 	len   int
+	// stableOrder keeps updated keys in place instead of moving them to the end.
+	stableOrder bool
 }
 
 // entry represents a single key-value pair in the ordered map's linked list.
@@ -25,24 +28,44 @@ type entry[K comparable, V any] struct {
 }
 
 // Item represents a key-value pair from the ordered map.
-type Item[K comparable, V any] struct {
+type Item[K any, V any] struct {
 	Key   K
 	Value V
 }
 
+// Option defines a functional option for OrderedMap configuration.
+type Option[K comparable, V any] func(OrderedMap[K, V]) OrderedMap[K, V]
+
+// WithStableOrder makes Set keep an existing key at its original position when updating its value,
+// so the map preserves the order in which keys were first inserted. This suits data such as config
+// files, where editing a value must not reorder entries. Deleting and re-setting a key still moves it to the end.
+func WithStableOrder[K comparable, V any]() Option[K, V] {
+	return func(m OrderedMap[K, V]) OrderedMap[K, V] {
+		m.stableOrder = true
+		return m
+	}
+}
+
 // New creates and returns a new empty OrderedMap.
-func New[K comparable, V any]() OrderedMap[K, V] {
-	return OrderedMap[K, V]{
+func New[K comparable, V any](opts ...Option[K, V]) OrderedMap[K, V] {
+	m := OrderedMap[K, V]{
 		items: make(map[K]*entry[K, V]),
 	}
+	for _, opt := range opts {
+		m = opt(m)
+	}
+	return m
 }
 
 // Set inserts or updates a key-value pair.
-// If the key already exists, its value is updated and the key is moved to the end.
+// If the key already exists, its value is updated and the key is moved to the end,
+// unless the map was created WithStableOrder.
 func (m *OrderedMap[K, V]) Set(key K, value V) {
 	if e, exists := m.items[key]; exists {
 		e.value = value
-		m.moveToBack(e)
+		if !m.stableOrder {
+			m.moveToBack(e)
+		}
 		return
 	}
 
@@ -55,6 +78,118 @@ func (m *OrderedMap[K, V]) Set(key K, value V) {
 	m.len++
 }
 
+// SetBefore inserts or updates a key-value pair and places the key immediately before mark.
+// An existing key is moved, whether or not the map was created WithStableOrder.
+// Returns false, leaving the map unchanged, if mark is not in the map.
+// If key equals mark, only the value is updated.
+func (m *OrderedMap[K, V]) SetBefore(mark, key K, value V) bool {
+	at, exists := m.items[mark]
+	if !exists {
+		return false
+	}
+	if at.key == key {
+		at.value = value
+		return true
+	}
+	m.insertBefore(m.detach(key, value), at)
+	return true
+}
+
+// SetAfter inserts or updates a key-value pair and places the key immediately after mark.
+// An existing key is moved, whether or not the map was created WithStableOrder.
+// Returns false, leaving the map unchanged, if mark is not in the map.
+// If key equals mark, only the value is updated.
+func (m *OrderedMap[K, V]) SetAfter(mark, key K, value V) bool {
+	at, exists := m.items[mark]
+	if !exists {
+		return false
+	}
+	if at.key == key {
+		at.value = value
+		return true
+	}
+	m.insertAfter(m.detach(key, value), at)
+	return true
+}
+
+// InsertAt inserts or updates a key-value pair and places the key at position index, counted
+// from 0 among the other keys, so that GetAt(index) returns it afterwards.
+// An existing key is moved, whether or not the map was created WithStableOrder.
+// Returns false, leaving the map unchanged, if index is negative or greater than the number of other keys.
+func (m *OrderedMap[K, V]) InsertAt(index int, key K, value V) bool {
+	current := m.IndexOf(key)
+	others := m.len
+	if current >= 0 {
+		others--
+	}
+	if index < 0 || index > others {
+		return false
+	}
+	if index == others {
+		m.addToBack(m.detach(key, value))
+		return true
+	}
+	// Find the entry to insert before while the key is still linked: positions
+	// from the key's current one onwards shift by one once it is removed.
+	pos := index
+	if current >= 0 && current <= index {
+		pos++
+	}
+	mark := m.entryAt(pos)
+	m.insertBefore(m.detach(key, value), mark)
+	return true
+}
+
+// MoveToFront moves an existing key to the front of the map.
+// Returns false if the key is not in the map.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	e, exists := m.items[key]
+	if !exists {
+		return false
+	}
+	if e != m.head {
+		m.removeEntry(e)
+		m.insertBefore(e, m.head)
+	}
+	return true
+}
+
+// MoveToBack moves an existing key to the back of the map, as if it had just been inserted.
+// Returns false if the key is not in the map.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	e, exists := m.items[key]
+	if !exists {
+		return false
+	}
+	m.moveToBack(e)
+	return true
+}
+
+// GetAt returns the key-value pair at position index in insertion order, counted from 0.
+// Returns an Option containing the item if index is in range, None otherwise.
+// It walks the list from the nearer end, so it is O(n).
+func (m *OrderedMap[K, V]) GetAt(index int) optional.Option[Item[K, V]] {
+	if index < 0 || index >= m.len {
+		return optional.None[Item[K, V]]()
+	}
+	e := m.entryAt(index)
+	return optional.Some(Item[K, V]{Key: e.key, Value: e.value})
+}
+
+// IndexOf returns the position of key in insertion order, counted from 0, or -1 if the key
+// is not in the map. It walks the list, so it is O(n).
+func (m *OrderedMap[K, V]) IndexOf(key K) int {
+	target, exists := m.items[key]
+	if !exists {
+		return -1
+	}
+	i := 0
+	for e := m.head; e != target; e = e.next {
+		i++
+	}
+	return i
+}
+
 // Get retrieves the value for a key.
 // Returns an Option containing the value if found, None otherwise.
 func (m *OrderedMap[K, V]) Get(key K) optional.Option[V] {
@@ -132,6 +267,27 @@ func (m *OrderedMap[K, V]) Range(fn func(K, V) bool) {
 	}
 }
 
+// ReverseRange iterates over all key-value pairs in reverse insertion order, newest first.
+// If the function returns false, iteration stops.
+func (m *OrderedMap[K, V]) ReverseRange(fn func(K, V) bool) {
+	for e := m.tail; e != nil; e = e.prev {
+		if !fn(e.key, e.value) {
+			return
+		}
+	}
+}
+
+// Seq returns an iter.Seq2 that yields all key-value pairs in insertion order.
+// This enables use with Go 1.23 for-range loops without copying the entries.
+func (m *OrderedMap[K, V]) Seq() iter.Seq2[K, V] {
+	return m.Range
+}
+
+// ReverseSeq returns an iter.Seq2 that yields all key-value pairs in reverse insertion order.
+func (m *OrderedMap[K, V]) ReverseSeq() iter.Seq2[K, V] {
+	return m.ReverseRange
+}
+
 // First returns the first inserted key-value pair.
 // Returns an Option containing the item if the map is not empty, None otherwise.
 func (m *OrderedMap[K, V]) First() optional.Option[Item[K, V]] {
@@ -180,9 +336,10 @@ func (m *OrderedMap[K, V]) PopLast() optional.Option[Item[K, V]] {
 
 // Clone creates a deep copy of the OrderedMap with independent internal structures.
 // Modifications to the clone will not affect the original map and vice versa.
-// The clone preserves the insertion order of the original map.
+// The clone preserves the insertion order and the options of the original map.
 func (m *OrderedMap[K, V]) Clone() OrderedMap[K, V] {
 	clone := New[K, V]()
+	clone.stableOrder = m.stableOrder
 	for e := m.head; e != nil; e = e.next {
 		clone.Set(e.key, e.value)
 	}
@@ -213,6 +370,64 @@ func (m *OrderedMap[K, V]) moveToBack(e *entry[K, V]) {
 	m.addToBack(e)
 }
 
+// detach returns the entry for key with its value set, unlinked from the list so that the caller
+// can place it. A new entry is created and counted if the key is not in the map yet.
+func (m *OrderedMap[K, V]) detach(key K, value V) *entry[K, V] {
+	if e, exists := m.items[key]; exists {
+		e.value = value
+		m.removeEntry(e)
+		return e
+	}
+	e := &entry[K, V]{
+		key:   key,
+		value: value,
+	}
+	m.items[key] = e
+	m.len++
+	return e
+}
+
+// insertBefore links an unlinked entry e immediately before mark.
+func (m *OrderedMap[K, V]) insertBefore(e, mark *entry[K, V]) {
+	e.prev = mark.prev
+	e.next = mark
+	if mark.prev != nil {
+		mark.prev.next = e
+	} else {
+		m.head = e
+	}
+	mark.prev = e
+}
+
+// insertAfter links an unlinked entry e immediately after mark.
+func (m *OrderedMap[K, V]) insertAfter(e, mark *entry[K, V]) {
+	e.prev = mark
+	e.next = mark.next
+	if mark.next != nil {
+		mark.next.prev = e
+	} else {
+		m.tail = e
+	}
+	mark.next = e
+}
+
+// entryAt returns the entry at position index, which must be in range,
+// walking from whichever end of the list is nearer.
+func (m *OrderedMap[K, V]) entryAt(index int) *entry[K, V] {
+	if index < m.len/2 {
+		e := m.head
+		for range index {
+			e = e.next
+		}
+		return e
+	}
+	e := m.tail
+	for range m.len - 1 - index {
+		e = e.prev
+	}
+	return e
+}
+
 // removeEntry removes an entry from the linked list without deleting from the map.
 // Updates the prev/next pointers of neighboring entries to maintain list integrity.
 func (m *OrderedMap[K, V]) removeEntry(e *entry[K, V]) {
@@ -59,6 +59,39 @@ func TestOrderedMapUpdateMovesToEnd(t *testing.T) {
 	}
 }
 
+func TestOrderedMapStableOrder(t *testing.T) {
+	m := New(WithStableOrder[string, int]())
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	m.Set("a", 10)
+
+	keys := m.Keys()
+	expected := []string{"a", "b", "c"}
+
+	for i, key := range keys {
+		if key != expected[i] {
+			t.Errorf("Expected key %s at %d, got %s", expected[i], i, key)
+		}
+	}
+
+	if opt := m.Get("a"); opt.MustGet() != 10 {
+		t.Errorf("Expected a=10, got %v", opt.Get())
+	}
+
+	clone := m.Clone()
+	clone.Set("b", 20)
+	if keys := clone.Keys(); keys[1] != "b" {
+		t.Errorf("Expected clone to keep stable order, got %v", keys)
+	}
+
+	m.Delete("a")
+	m.Set("a", 1)
+	if last := m.Last().MustGet(); last.Key != "a" {
+		t.Errorf("Expected re-inserted key at the end, got %v", last.Key)
+	}
+}
+
 func TestOrderedMapFirstLast(t *testing.T) {
 	m := New[string, int]()
 	m.Set("first", 1)
@@ -137,3 +170,115 @@ func TestOrderedMapRange(t *testing.T) {
 		t.Errorf("Expected 3 iterations, got %d", count)
 	}
 }
+
+func TestOrderedMapSeq(t *testing.T) {
+	m := New[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	var forward []string
+	for k, v := range m.Seq() {
+		forward = append(forward, k)
+		if v != m.Get(k).MustGet() {
+			t.Errorf("Expected %s=%d, got %d", k, m.Get(k).MustGet(), v)
+		}
+	}
+	var backward []string
+	for k := range m.ReverseSeq() {
+		backward = append(backward, k)
+		if k == "b" {
+			break
+		}
+	}
+
+	if len(forward) != 3 || forward[0] != "a" || forward[2] != "c" {
+		t.Errorf("Expected [a b c], got %v", forward)
+	}
+	if len(backward) != 2 || backward[0] != "c" || backward[1] != "b" {
+		t.Errorf("Expected [c b], got %v", backward)
+	}
+}
+
+func TestOrderedMapPositional(t *testing.T) {
+	m := New[string, int]()
+	m.Set("auth", 1)
+	m.Set("log", 2)
+
+	if !m.SetBefore("log", "cors", 3) || !m.SetAfter("log", "gzip", 4) {
+		t.Error("Expected SetBefore and SetAfter to find the mark")
+	}
+	if m.SetBefore("missing", "x", 0) || m.Has("x") {
+		t.Error("Expected SetBefore with a missing mark to leave the map unchanged")
+	}
+	expectKeys(t, &m, "auth", "cors", "log", "gzip")
+
+	m.SetAfter("gzip", "auth", 10)
+	expectKeys(t, &m, "cors", "log", "gzip", "auth")
+	if m.Get("auth").MustGet() != 10 || m.Len() != 4 {
+		t.Errorf("Expected auth=10 and len 4, got %v and %d", m.Get("auth").Get(), m.Len())
+	}
+
+	m.MoveToFront("gzip")
+	m.MoveToBack("cors")
+	expectKeys(t, &m, "gzip", "log", "auth", "cors")
+
+	m.InsertAt(0, "first", 0)
+	m.InsertAt(5, "last", 0)
+	m.InsertAt(2, "first", 0)
+	m.InsertAt(1, "auth", 10)
+	expectKeys(t, &m, "gzip", "auth", "log", "first", "cors", "last")
+	if m.InsertAt(7, "x", 0) || m.InsertAt(-1, "x", 0) {
+		t.Error("Expected InsertAt out of range to fail")
+	}
+
+	for i, key := range m.Keys() {
+		if m.IndexOf(key) != i || m.GetAt(i).MustGet().Key != key {
+			t.Errorf("Expected %s at index %d", key, i)
+		}
+	}
+	if m.IndexOf("missing") != -1 || m.GetAt(6).IsPresent() {
+		t.Error("Expected no entry for missing key or index")
+	}
+}
+
+func TestOrderedMapPositionalSelfMark(t *testing.T) {
+	m := New[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	if !m.SetBefore("b", "b", 20) || !m.SetAfter("c", "c", 30) || !m.SetAfter("a", "a", 10) {
+		t.Error("Expected SetBefore and SetAfter to find the mark")
+	}
+	expectKeys(t, &m, "a", "b", "c")
+	if m.Len() != 3 || m.Get("a").MustGet() != 10 || m.Get("b").MustGet() != 20 || m.Get("c").MustGet() != 30 {
+		t.Errorf("Expected only the values to change, got %v", m.Items())
+	}
+
+	m.Set("d", 4)
+	expectKeys(t, &m, "a", "b", "c", "d")
+}
+
+func expectKeys(t *testing.T, m *OrderedMap[string, int], expected ...string) {
+	t.Helper()
+	keys := m.Keys()
+	if len(keys) != len(expected) {
+		t.Fatalf("Expected keys %v, got %v", expected, keys)
+	}
+	for i, key := range keys {
+		if key != expected[i] {
+			t.Fatalf("Expected keys %v, got %v", expected, keys)
+		}
+	}
+	var backward []string
+	m.ReverseRange(func(k string, _ int) bool {
+		backward = append(backward, k)
+		return true
+	})
+	for i, key := range backward {
+		if key != expected[len(expected)-1-i] {
+			t.Fatalf("Expected reverse order of %v, got %v", expected, backward)
+		}
+	}
+}
@@ -0,0 +1,413 @@
+package omap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// SortedMap is a map that keeps its keys sorted.
+// It is backed by an AVL tree, so lookups, insertions and deletions are O(log n),
+// and iteration visits keys in ascending order.
+type SortedMap[K any, V any] struct {
+	root    *node[K, V]
+	len     int
+	compare func(a, b K) int
+}
+
+// node is a single key-value pair in the sorted map's AVL tree.
+type node[K any, V any] struct {
+	key    K
+	value  V
+	left   *node[K, V]
+	right  *node[K, V]
+	height int
+}
+
+// NewSorted creates and returns a new empty SortedMap ordered by the natural order of its keys.
+func NewSorted[K cmp.Ordered, V any]() SortedMap[K, V] {
+	return SortedMap[K, V]{compare: cmp.Compare[K]}
+}
+
+// NewSortedFunc creates and returns a new empty SortedMap ordered by compare, which returns a
+// negative number if a sorts before b, a positive number if it sorts after, and 0 if they are equal.
+// Keys that compare equal are treated as the same key.
+func NewSortedFunc[K any, V any](compare func(a, b K) int) SortedMap[K, V] {
+	return SortedMap[K, V]{compare: compare}
+}
+
+// Set inserts or updates a key-value pair.
+func (m *SortedMap[K, V]) Set(key K, value V) {
+	m.root = m.insert(m.root, key, value)
+}
+
+// Get retrieves the value for a key.
+// Returns an Option containing the value if found, None otherwise.
+func (m *SortedMap[K, V]) Get(key K) optional.Option[V] {
+	n := m.find(key)
+	if n == nil {
+		return optional.None[V]()
+	}
+	return optional.Some(n.value)
+}
+
+// Delete removes a key-value pair from the map.
+// Returns true if the key was present and removed, false otherwise.
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	before := m.len
+	m.root = m.remove(m.root, key)
+	return m.len < before
+}
+
+// Has checks if a key exists in the map.
+func (m *SortedMap[K, V]) Has(key K) bool {
+	return m.find(key) != nil
+}
+
+// Len returns the number of key-value pairs in the map.
+func (m *SortedMap[K, V]) Len() int {
+	return m.len
+}
+
+// Clear removes all key-value pairs from the map.
+func (m *SortedMap[K, V]) Clear() {
+	m.root = nil
+	m.len = 0
+}
+
+// Keys returns a slice of all keys in ascending order.
+func (m *SortedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.len)
+	m.Range(func(k K, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
+}
+
+// Values returns a slice of all values in ascending key order.
+func (m *SortedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.len)
+	m.Range(func(_ K, v V) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// Items returns a slice of all key-value pairs in ascending key order.
+func (m *SortedMap[K, V]) Items() []Item[K, V] {
+	items := make([]Item[K, V], 0, m.len)
+	m.Range(func(k K, v V) bool {
+		items = append(items, Item[K, V]{Key: k, Value: v})
+		return true
+	})
+	return items
+}
+
+// Range iterates over all key-value pairs in ascending key order.
+// If the function returns false, iteration stops.
+func (m *SortedMap[K, V]) Range(fn func(K, V) bool) {
+	m.ascend(m.root, nil, nil, fn)
+}
+
+// ReverseRange iterates over all key-value pairs in descending key order.
+// If the function returns false, iteration stops.
+func (m *SortedMap[K, V]) ReverseRange(fn func(K, V) bool) {
+	m.descend(m.root, fn)
+}
+
+// Seq returns an iter.Seq2 that yields all key-value pairs in ascending key order.
+func (m *SortedMap[K, V]) Seq() iter.Seq2[K, V] {
+	return m.Range
+}
+
+// ReverseSeq returns an iter.Seq2 that yields all key-value pairs in descending key order.
+func (m *SortedMap[K, V]) ReverseSeq() iter.Seq2[K, V] {
+	return m.ReverseRange
+}
+
+// Between returns an iter.Seq2 that yields the key-value pairs with from <= key < to,
+// in ascending key order. Only the matching part of the tree is visited, so iterating
+// a narrow range of a large map is O(log n + k) for k matching keys.
+func (m *SortedMap[K, V]) Between(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascend(m.root, &from, &to, yield)
+	}
+}
+
+// First returns the key-value pair with the smallest key.
+// Returns an Option containing the item if the map is not empty, None otherwise.
+func (m *SortedMap[K, V]) First() optional.Option[Item[K, V]] {
+	n := m.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return itemOf(n)
+}
+
+// Last returns the key-value pair with the largest key.
+// Returns an Option containing the item if the map is not empty, None otherwise.
+func (m *SortedMap[K, V]) Last() optional.Option[Item[K, V]] {
+	n := m.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return itemOf(n)
+}
+
+// PopFirst removes and returns the key-value pair with the smallest key.
+// Returns an Option containing the item if the map was not empty, None otherwise.
+func (m *SortedMap[K, V]) PopFirst() optional.Option[Item[K, V]] {
+	first := m.First()
+	if first.IsPresent() {
+		m.Delete(first.MustGet().Key)
+	}
+	return first
+}
+
+// PopLast removes and returns the key-value pair with the largest key.
+// Returns an Option containing the item if the map was not empty, None otherwise.
+func (m *SortedMap[K, V]) PopLast() optional.Option[Item[K, V]] {
+	last := m.Last()
+	if last.IsPresent() {
+		m.Delete(last.MustGet().Key)
+	}
+	return last
+}
+
+// Floor returns the key-value pair with the largest key less than or equal to key.
+// Returns None if every key in the map is greater than key.
+func (m *SortedMap[K, V]) Floor(key K) optional.Option[Item[K, V]] {
+	var found *node[K, V]
+	for n := m.root; n != nil; {
+		c := m.compare(key, n.key)
+		if c == 0 {
+			found = n
+			break
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			found = n
+			n = n.right
+		}
+	}
+	return itemOf(found)
+}
+
+// Ceiling returns the key-value pair with the smallest key greater than or equal to key.
+// Returns None if every key in the map is less than key.
+func (m *SortedMap[K, V]) Ceiling(key K) optional.Option[Item[K, V]] {
+	var found *node[K, V]
+	for n := m.root; n != nil; {
+		c := m.compare(key, n.key)
+		if c == 0 {
+			found = n
+			break
+		}
+		if c > 0 {
+			n = n.right
+		} else {
+			found = n
+			n = n.left
+		}
+	}
+	return itemOf(found)
+}
+
+// Clone creates a copy of the SortedMap with an independent tree.
+// Modifications to the clone will not affect the original map and vice versa.
+func (m *SortedMap[K, V]) Clone() SortedMap[K, V] {
+	return SortedMap[K, V]{root: cloneNode(m.root), len: m.len, compare: m.compare}
+}
+
+// String returns a string representation of the SortedMap.
+func (m *SortedMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("SortedMap{")
+	first := true
+	m.Range(func(k K, v V) bool {
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		fmt.Fprintf(&sb, "%v:%v", k, v)
+		return true
+	})
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// find returns the node holding key, or nil.
+func (m *SortedMap[K, V]) find(key K) *node[K, V] {
+	n := m.root
+	for n != nil {
+		c := m.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// ascend calls fn in ascending order for the keys of the subtree n with from <= key < to,
+// where a nil bound is unbounded. It returns false if fn stopped the iteration.
+func (m *SortedMap[K, V]) ascend(n *node[K, V], from, to *K, fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveFrom := from == nil || m.compare(n.key, *from) >= 0
+	belowTo := to == nil || m.compare(n.key, *to) < 0
+	if aboveFrom && !m.ascend(n.left, from, to, fn) {
+		return false
+	}
+	if aboveFrom && belowTo && !fn(n.key, n.value) {
+		return false
+	}
+	if belowTo {
+		return m.ascend(n.right, from, to, fn)
+	}
+	return true
+}
+
+// descend calls fn in descending order for the keys of the subtree n.
+// It returns false if fn stopped the iteration.
+func (m *SortedMap[K, V]) descend(n *node[K, V], fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return m.descend(n.right, fn) && fn(n.key, n.value) && m.descend(n.left, fn)
+}
+
+// insert adds or updates key in the subtree n and returns the new, rebalanced subtree root.
+func (m *SortedMap[K, V]) insert(n *node[K, V], key K, value V) *node[K, V] {
+	if n == nil {
+		m.len++
+		return &node[K, V]{key: key, value: value, height: 1}
+	}
+	c := m.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left = m.insert(n.left, key, value)
+	case c > 0:
+		n.right = m.insert(n.right, key, value)
+	default:
+		n.value = value
+		return n
+	}
+	return rebalance(n)
+}
+
+// remove deletes key from the subtree n and returns the new, rebalanced subtree root.
+func (m *SortedMap[K, V]) remove(n *node[K, V], key K) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := m.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left = m.remove(n.left, key)
+	case c > 0:
+		n.right = m.remove(n.right, key)
+	default:
+		m.len--
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		// Replace n by its in-order successor, the smallest node of the right subtree.
+		var successor *node[K, V]
+		n.right = removeMin(n.right, &successor)
+		successor.left = n.left
+		successor.right = n.right
+		n = successor
+	}
+	return rebalance(n)
+}
+
+// removeMin unlinks the smallest node of the subtree n, stores it in removed,
+// and returns the new, rebalanced subtree root.
+func removeMin[K, V any](n *node[K, V], removed **node[K, V]) *node[K, V] {
+	if n.left == nil {
+		*removed = n
+		return n.right
+	}
+	n.left = removeMin(n.left, removed)
+	return rebalance(n)
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[K, V]) update() {
+	n.height = 1 + max(height(n.left), height(n.right))
+}
+
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// rebalance restores the AVL invariant at n, whose subtrees differ in height by at most two,
+// and returns the new subtree root.
+func rebalance[K, V any](n *node[K, V]) *node[K, V] {
+	n.update()
+	switch balance := height(n.left) - height(n.right); {
+	case balance > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func cloneNode[K, V any](n *node[K, V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left = cloneNode(n.left)
+	c.right = cloneNode(n.right)
+	return &c
+}
+
+func itemOf[K, V any](n *node[K, V]) optional.Option[Item[K, V]] {
+	if n == nil {
+		return optional.None[Item[K, V]]()
+	}
+	return optional.Some(Item[K, V]{Key: n.key, Value: n.value})
+}
@@ -0,0 +1,149 @@
+package omap
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSortedMapBasic(t *testing.T) {
+	m := NewSorted[int, string]()
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		m.Set(k, strings.Repeat("x", k/10))
+	}
+	m.Set(20, "updated")
+
+	if m.Len() != 5 {
+		t.Errorf("Expected len 5, got %d", m.Len())
+	}
+	if keys := m.Keys(); !slices.Equal(keys, []int{10, 20, 30, 40, 50}) {
+		t.Errorf("Expected sorted keys, got %v", keys)
+	}
+	if opt := m.Get(20); opt.MustGet() != "updated" {
+		t.Errorf("Expected 20=updated, got %v", opt.Get())
+	}
+	if !m.Delete(30) || m.Delete(30) || m.Has(30) {
+		t.Error("Expected 30 to be deleted exactly once")
+	}
+
+	if first := m.PopFirst().MustGet(); first.Key != 10 {
+		t.Errorf("Expected PopFirst to return 10, got %v", first.Key)
+	}
+	if last := m.Last().MustGet(); last.Key != 50 {
+		t.Errorf("Expected Last to return 50, got %v", last.Key)
+	}
+
+	var backward []int
+	for k := range m.ReverseSeq() {
+		backward = append(backward, k)
+	}
+	if !slices.Equal(backward, []int{50, 40, 20}) {
+		t.Errorf("Expected [50 40 20], got %v", backward)
+	}
+}
+
+func TestSortedMapFloorCeilingBetween(t *testing.T) {
+	m := NewSorted[int, int]()
+	for k := 0; k < 100; k += 10 {
+		m.Set(k, k)
+	}
+
+	tests := []struct {
+		key            int
+		floor, ceiling int // -1 means none
+	}{
+		{-5, -1, 0},
+		{0, 0, 0},
+		{35, 30, 40},
+		{90, 90, 90},
+		{95, 90, -1},
+	}
+	for _, tt := range tests {
+		floor, ceiling := m.Floor(tt.key), m.Ceiling(tt.key)
+		if (tt.floor < 0) == floor.IsPresent() || (floor.IsPresent() && floor.MustGet().Key != tt.floor) {
+			t.Errorf("Floor(%d): expected %d, got %v", tt.key, tt.floor, floor)
+		}
+		if (tt.ceiling < 0) == ceiling.IsPresent() || (ceiling.IsPresent() && ceiling.MustGet().Key != tt.ceiling) {
+			t.Errorf("Ceiling(%d): expected %d, got %v", tt.key, tt.ceiling, ceiling)
+		}
+	}
+
+	var between []int
+	for k := range m.Between(25, 60) {
+		between = append(between, k)
+	}
+	if !slices.Equal(between, []int{30, 40, 50}) {
+		t.Errorf("Expected [30 40 50], got %v", between)
+	}
+	for range m.Between(0, 100) {
+		break
+	}
+}
+
+func TestSortedMapFunc(t *testing.T) {
+	m := NewSortedFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	m.Set("b", 1)
+	m.Set("A", 2)
+	m.Set("B", 3)
+	if m.Len() != 2 || m.Get("b").MustGet() != 3 {
+		t.Errorf("Expected case-insensitive keys, got %v", m.String())
+	}
+	if m.String() != "SortedMap{A:2, b:3}" {
+		t.Errorf("Expected SortedMap{A:2, b:3}, got %s", m.String())
+	}
+}
+
+func TestSortedMapRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	m := NewSorted[int, int]()
+	reference := make(map[int]int)
+	for i := 0; i < 5000; i++ {
+		k := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			_, exists := reference[k]
+			if m.Delete(k) != exists {
+				t.Fatalf("Delete(%d): expected %v", k, exists)
+			}
+			delete(reference, k)
+		} else {
+			m.Set(k, i)
+			reference[k] = i
+		}
+	}
+
+	if m.Len() != len(reference) {
+		t.Fatalf("Expected len %d, got %d", len(reference), m.Len())
+	}
+	keys := m.Keys()
+	if !slices.IsSorted(keys) {
+		t.Error("Expected keys in ascending order")
+	}
+	for _, k := range keys {
+		if m.Get(k).MustGet() != reference[k] {
+			t.Errorf("Expected %d=%d, got %d", k, reference[k], m.Get(k).MustGet())
+		}
+	}
+	checkBalanced(t, m.root)
+
+	clone := m.Clone()
+	clone.Clear()
+	if m.Len() != len(reference) || clone.Len() != 0 {
+		t.Error("Expected clone to be independent")
+	}
+}
+
+// checkBalanced verifies the AVL invariants of the subtree n and returns its height.
+func checkBalanced(t *testing.T, n *node[int, int]) int {
+	t.Helper()
+	if n == nil {
+		return 0
+	}
+	l, r := checkBalanced(t, n.left), checkBalanced(t, n.right)
+	if l-r > 1 || r-l > 1 || n.height != 1+max(l, r) {
+		t.Fatalf("Unbalanced node %d: left %d, right %d, height %d", n.key, l, r, n.height)
+	}
+	return n.height
+}
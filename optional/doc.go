@@ -55,11 +55,16 @@
 //   - Get() - returns the value (even if absent, returns zero value)
 //   - MustGet() - returns the value or panics if absent
 //   - GetErr() - returns the value and an error if absent
+//   - GetOrErr(format, args...) - returns the value or a descriptive formatted error
+//   - Unpack() - returns the value and a boolean, like a map lookup
+//   - TakeIf(predicate) - like Unpack, but only if the value matches the predicate
 //   - OrElse(fallback) - returns the value or a fallback
-//   - OrElseGet(supplier) - returns the value or calls a supplier function
+//   - OrElseGet(supplier) - returns the value or calls a supplier function (only when absent)
+//   - OrElseLazy(lazy) - returns the value or the value of a *lazy.Lazy default, computed at most once
 //   - OrElseErr(supplier) - returns the value or an error from a supplier
 //   - OrEmpty() - returns the value or the zero value of T
 //   - OrPanic(message) - returns the value or panics with a custom message
+//   - Expect(message) - returns the value or panics with the message and the reason
 //   - Ptr() - returns a pointer to the value, or nil if absent
 //
 // Example:
@@ -78,6 +83,22 @@
 //	    fmt.Println("Error:", err)
 //	}
 //
+//	// Comma-ok style
+//	if v, ok := opt.Unpack(); ok {
+//	    fmt.Println("Value:", v)
+//	}
+//
+//	// Validate required settings at startup
+//	err := optional.UnwrapAll(map[string]interface{ IsPresent() bool }{
+//	    "host":  cfg.Host,
+//	    "port":  cfg.Port,
+//	    "token": cfg.Token,
+//	})
+//	if err != nil {
+//	    log.Fatal(err) // required options are absent: host, token
+//	}
+//	port, err := cfg.Port.GetOrErr("config: %s is required", "port")
+//
 // # Conditional Execution
 //
 // Execute code conditionally based on the presence of a value:
@@ -151,7 +172,49 @@
 //	result5 := opt1.Xor(none) // Some(1)
 //	result6 := opt1.Xor(opt2) // None (both are Some)
 //
+//	// ZipWith: combine values only if both are Some
+//	sum := optional.ZipWith(opt1, opt2, func(a, b int) int { return a + b }) // Some(3)
+//	none2 := optional.ZipWith(opt1, none, func(a, b int) int { return a + b }) // None
+//
 
+// # Working with Slices
+//
+//	opts := []optional.Option[int]{optional.Some(1), optional.None[int](), optional.Some(3)}
+//
+//	// Values drops absent options
+//	values := optional.Values(opts) // [1, 3]
+//
+//	// TraverseSlice succeeds only if every element maps to Some
+//	ports := optional.TraverseSlice([]string{"80", "443"}, func(s string) optional.Option[int] {
+//	    return optional.TryMap(optional.Some(s), strconv.Atoi)
+//	}) // Some([80, 443])
+//
+//	// FirstSome picks the first present option, e.g. for layered config
+//	port := optional.FirstSome(flagPort, envPort, filePort)
+//
+// Safe accessors return None instead of panicking or returning a zero value:
+//
+//	optional.Index(args, 1)          // Some(args[1]) or None if out of range
+//	optional.First(results)          // None if empty
+//	optional.Last(history)           // None if empty
+//	optional.MapGet(headers, "Host") // None if the key is absent
+//
+//	host := optional.MapGet(headers, "Host").OrElse("localhost")
+//
+// # Layered Configuration
+//
+// Options have three states: Some (a value), None (not set) and Nil (explicitly set to null).
+// Merge and Coalesce use the distinction to layer configuration sources, such as
+// defaults < file < env < flags: None leaves the lower layer untouched, while Nil clears it.
+//
+//	timeout := optional.Merge(defaults.Timeout, file.Timeout) // file wins unless None
+//
+//	// Highest priority first; the first Some or Nil wins
+//	proxy := optional.Coalesce(flags.Proxy, env.Proxy, file.Proxy, defaults.Proxy)
+//	if proxy.IsNil() {
+//	    // explicitly disabled, even though defaults set one
+//	}
+//
 // # JSON Serialization
 //
 // Options automatically support JSON marshaling and unmarshaling:
@@ -176,6 +239,44 @@
 //	fmt.Println(decoded.Email.OrEmpty()) // "alice@example.com"
 //	fmt.Println(decoded.Age.IsPresent()) // false
 //
+// # Text, XML and YAML
+//
+// Options also implement encoding.TextMarshaler/TextUnmarshaler, xml.Marshaler/Unmarshaler,
+// and the YAML marshaling methods understood by gopkg.in/yaml.v2 and v3:
+//
+//	type Config struct {
+//	    Port    optional.Option[int]    `yaml:"port" xml:"port"`
+//	    Timeout optional.Option[string] `yaml:"timeout" xml:"timeout"`
+//	}
+//
+// Missing YAML keys, YAML null and missing XML elements decode as None.
+// Empty text decodes as None, so blank form and query fields stay absent.
+//
+// # Flags and Environment Variables
+//
+// Options solve the classic "was this flag set?" problem: they stay None unless
+// the flag or variable is actually provided:
+//
+//	var port optional.Option[int]
+//	optional.FlagVar(nil, &port, "port", "listen port")
+//	flag.Parse()
+//
+//	if port.IsAbsent() {
+//	    port, _ = optional.FromEnv[int]("PORT")
+//	}
+//
+// # Database Integration
+//
+// Options implement sql.Scanner and driver.Valuer, so they can be scanned from
+// sql.Rows and passed as query parameters. SQL NULL maps to None:
+//
+//	var email optional.Option[string]
+//	err := db.QueryRow("SELECT email FROM users WHERE id = ?", id).Scan(&email)
+//
+//	_, err = db.Exec("UPDATE users SET email = ? WHERE id = ?", email, id)
+//
+// Custom types work as well when they implement sql.Scanner and driver.Valuer themselves.
+//
 // # Comparison
 //
 // Compare two Options using a custom equality function:
@@ -0,0 +1,154 @@
+package optional
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+// Absent values are marshaled as empty text. Present values use the value's own
+// TextMarshaler if it has one, time.Duration values are formatted like "1m30s",
+// and other strings, booleans and numbers are formatted with strconv.
+//
+// A present value whose text is empty, such as Some(""), is therefore indistinguishable
+// from None and unmarshals as None; see UnmarshalText.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if o.state != statePresent {
+		return []byte{}, nil
+	}
+	if m, ok := any(o.value).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	if d, ok := any(o.value).(time.Duration); ok {
+		return []byte(d.String()), nil
+	}
+
+	v := reflect.ValueOf(o.value)
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("optional: cannot marshal %T as text", o.value)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Empty text is unmarshaled as None, which matches how form and query binding
+// libraries report a field that was left blank. This holds even for string options:
+// Some("") marshals to empty text and comes back as None.
+// Any other text is parsed into T and unmarshaled as Some.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := parseText(string(text), &value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// parseText parses s into value, using its TextUnmarshaler if it has one,
+// time.ParseDuration for a time.Duration, and strconv for other strings, booleans and numbers.
+func parseText[T any](s string, value *T) error {
+	if u, ok := any(value).(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	if d, ok := any(value).(*time.Duration); ok {
+		parsed, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	}
+
+	v := reflect.ValueOf(value).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("optional: cannot unmarshal text into %T", *value)
+	}
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// Absent values are omitted, present values are encoded as the element itself.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if o.state != statePresent {
+		return nil
+	}
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// A missing element leaves the Option as None, a present one is decoded as Some.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value T
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// MarshalYAML returns the value to encode in YAML.
+// Absent values are encoded as null. The signature matches the Marshaler interface of
+// gopkg.in/yaml.v2 and gopkg.in/yaml.v3, so this package does not depend on either.
+func (o Option[T]) MarshalYAML() (any, error) {
+	if o.state != statePresent {
+		return nil, nil
+	}
+	return o.value, nil
+}
+
+// UnmarshalYAML decodes a YAML value as Some.
+// It uses the unmarshal-callback signature that both gopkg.in/yaml.v2 and gopkg.in/yaml.v3 accept.
+// YAML null and missing keys leave the Option as None.
+func (o *Option[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var value T
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
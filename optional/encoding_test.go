@@ -0,0 +1,112 @@
+package optional_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// textRoundTrip marshals o as text, checks the text against expected and unmarshals it back.
+func textRoundTrip[T any](t *testing.T, o optional.Option[T], expected string) optional.Option[T] {
+	t.Helper()
+	text, err := o.MarshalText()
+	if err != nil {
+		t.Fatalf("marshal %v: %v", o, err)
+	}
+	if string(text) != expected {
+		t.Errorf("expected text %q, got %q", expected, text)
+	}
+	var decoded optional.Option[T]
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("unmarshal %q: %v", text, err)
+	}
+	return decoded
+}
+
+func TestText(t *testing.T) {
+	if got := textRoundTrip(t, optional.Some(42), "42"); got != optional.Some(42) {
+		t.Errorf("expected Some(42), got %v", got)
+	}
+	if got := textRoundTrip(t, optional.Some(-1.5), "-1.5"); got != optional.Some(-1.5) {
+		t.Errorf("expected Some(-1.5), got %v", got)
+	}
+	if got := textRoundTrip(t, optional.Some(true), "true"); got != optional.Some(true) {
+		t.Errorf("expected Some(true), got %v", got)
+	}
+	if got := textRoundTrip(t, optional.Some("gopher"), "gopher"); got != optional.Some("gopher") {
+		t.Errorf("expected Some(gopher), got %v", got)
+	}
+	if got := textRoundTrip(t, optional.Some(90*time.Second), "1m30s"); got != optional.Some(90*time.Second) {
+		t.Errorf("expected Some(1m30s), got %v", got)
+	}
+	addr := netip.MustParseAddr("10.0.0.1")
+	if got := textRoundTrip(t, optional.Some(addr), "10.0.0.1"); got != optional.Some(addr) {
+		t.Errorf("expected Some(10.0.0.1) through TextUnmarshaler, got %v", got)
+	}
+	if got := textRoundTrip(t, optional.None[int](), ""); !got.IsAbsent() {
+		t.Errorf("expected None, got %v", got)
+	}
+
+	// Empty text always means None, so an empty string does not survive a round trip.
+	if got := textRoundTrip(t, optional.Some(""), ""); got != optional.None[string]() {
+		t.Errorf("expected Some(\"\") to come back as None, got %v", got)
+	}
+
+	var o optional.Option[int]
+	if err := o.UnmarshalText([]byte("five")); err == nil {
+		t.Error("expected invalid text to fail")
+	}
+	if _, err := optional.Some([]int{1}).MarshalText(); err == nil {
+		t.Error("expected a slice to be rejected")
+	}
+}
+
+func TestXML(t *testing.T) {
+	type config struct {
+		XMLName xml.Name                `xml:"config"`
+		Port    optional.Option[int]    `xml:"port"`
+		Host    optional.Option[string] `xml:"host"`
+	}
+
+	data, err := xml.Marshal(config{Port: optional.Some(8080)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "<config><port>8080</port></config>" {
+		t.Errorf("expected the absent host to be omitted, got %s", data)
+	}
+
+	var decoded config
+	if err := xml.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Port != optional.Some(8080) || decoded.Host.IsPresent() {
+		t.Errorf("expected port 8080 and no host, got %v and %v", decoded.Port, decoded.Host)
+	}
+}
+
+func TestYAML(t *testing.T) {
+	// unmarshalFrom stands in for the callback a YAML library passes to UnmarshalYAML.
+	unmarshalFrom := func(doc string) func(any) error {
+		return func(v any) error { return json.Unmarshal([]byte(doc), v) }
+	}
+
+	if v, err := optional.Some(3).MarshalYAML(); err != nil || v != 3 {
+		t.Errorf("expected 3, got %v, %v", v, err)
+	}
+	if v, err := optional.None[int]().MarshalYAML(); err != nil || v != nil {
+		t.Errorf("expected nil for None, got %v, %v", v, err)
+	}
+
+	var o optional.Option[int]
+	if err := o.UnmarshalYAML(unmarshalFrom("7")); err != nil || o != optional.Some(7) {
+		t.Errorf("expected Some(7), got %v, %v", o, err)
+	}
+	if err := o.UnmarshalYAML(unmarshalFrom(`"seven"`)); err == nil {
+		t.Error("expected a mismatched value to fail")
+	}
+}
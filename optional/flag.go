@@ -0,0 +1,71 @@
+package optional
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+)
+
+// flagValue adapts an Option to the flag.Value interface.
+type flagValue[T any] struct {
+	opt *Option[T]
+}
+
+// String returns the text form of the value, or an empty string if absent.
+func (f flagValue[T]) String() string {
+	if f.opt == nil {
+		return ""
+	}
+	text, err := f.opt.MarshalText()
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+// Set parses s and stores it as Some.
+func (f flagValue[T]) Set(s string) error {
+	var value T
+	if err := parseText(s, &value); err != nil {
+		return err
+	}
+	*f.opt = Some(value)
+	return nil
+}
+
+// IsBoolFlag lets boolean options be set with a bare -name.
+func (f flagValue[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
+
+// FlagValue returns a flag.Value that stores into o.
+// The Option stays None unless the flag is actually passed on the command line,
+// which tells "not set" apart from "set to the zero value".
+func FlagValue[T any](o *Option[T]) flag.Value {
+	return flagValue[T]{opt: o}
+}
+
+// FlagVar defines a flag with the given name and usage that stores into o.
+// If fs is nil, the flag is defined on flag.CommandLine.
+func FlagVar[T any](fs *flag.FlagSet, o *Option[T], name, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	fs.Var(FlagValue(o), name, usage)
+}
+
+// FromEnv reads the environment variable key and parses it into T.
+// It returns None if the variable is not set, and Some if it is set, even to an empty string.
+// An error is returned if the value cannot be parsed into T.
+func FromEnv[T any](key string) (Option[T], error) {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return None[T](), nil
+	}
+	var value T
+	if err := parseText(s, &value); err != nil {
+		return None[T](), fmt.Errorf("optional: environment variable %s: %w", key, err)
+	}
+	return Some(value), nil
+}
@@ -0,0 +1,65 @@
+package optional_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+func TestFlagVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var timeout optional.Option[time.Duration]
+	var retries, workers optional.Option[int]
+	var verbose optional.Option[bool]
+	optional.FlagVar(fs, &timeout, "timeout", "request timeout")
+	optional.FlagVar(fs, &retries, "retries", "number of retries")
+	optional.FlagVar(fs, &workers, "workers", "number of workers")
+	optional.FlagVar(fs, &verbose, "v", "verbose output")
+
+	if err := fs.Parse([]string{"-timeout=5s", "-retries", "0", "-v"}); err != nil {
+		t.Fatal(err)
+	}
+	if timeout != optional.Some(5*time.Second) {
+		t.Errorf("expected timeout Some(5s), got %v", timeout)
+	}
+	if retries != optional.Some(0) {
+		t.Errorf("expected retries set to zero to be Some(0), got %v", retries)
+	}
+	if workers.IsPresent() {
+		t.Errorf("expected an unset flag to stay None, got %v", workers)
+	}
+	if verbose != optional.Some(true) {
+		t.Errorf("expected a bare boolean flag to be Some(true), got %v", verbose)
+	}
+	if got := fs.Lookup("timeout").Value.String(); got != "5s" {
+		t.Errorf("expected the flag to print 5s, got %q", got)
+	}
+
+	if err := fs.Parse([]string{"-retries=many"}); err == nil {
+		t.Error("expected an invalid flag value to fail")
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("OPTIONAL_TEST_TIMEOUT", "1m")
+	t.Setenv("OPTIONAL_TEST_NAME", "")
+	t.Setenv("OPTIONAL_TEST_PORT", "http")
+
+	if got, err := optional.FromEnv[time.Duration]("OPTIONAL_TEST_TIMEOUT"); err != nil || got != optional.Some(time.Minute) {
+		t.Errorf("expected Some(1m), got %v, %v", got, err)
+	}
+	if got, err := optional.FromEnv[string]("OPTIONAL_TEST_NAME"); err != nil || got != optional.Some("") {
+		t.Errorf("expected a variable set to empty to be Some(\"\"), got %v, %v", got, err)
+	}
+	if got, err := optional.FromEnv[int]("OPTIONAL_TEST_UNSET"); err != nil || got.IsPresent() {
+		t.Errorf("expected an unset variable to be None, got %v, %v", got, err)
+	}
+	if got, err := optional.FromEnv[int]("OPTIONAL_TEST_PORT"); err == nil || got.IsPresent() {
+		t.Errorf("expected an invalid value to fail with None, got %v, %v", got, err)
+	}
+}
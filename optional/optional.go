@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 )
 
 type state int8
@@ -82,8 +84,14 @@ func (o Option[T]) IsPresent() bool {
 }
 
 // IsAbsent returns true if the value is absent.
+// Both None and Nil are absent.
 func (o Option[T]) IsAbsent() bool {
-	return o.state != stateAbsent
+	return o.state != statePresent
+}
+
+// IsNil returns true if the Option is an explicit "null" created by Nil.
+func (o Option[T]) IsNil() bool {
+	return o.state == stateNil
 }
 
 // Get returns the value. Note that this returns the value even if absent.
@@ -112,6 +120,48 @@ func (o Option[T]) GetErr() (T, error) {
 	return o.value, nil
 }
 
+// GetOrErr returns the value if present, otherwise an error built from format and args with fmt.Errorf.
+// It is meant to name the missing value, for example opt.GetOrErr("config: %s is required", "port").
+func (o Option[T]) GetOrErr(format string, args ...any) (T, error) {
+	if o.state != statePresent {
+		var zero T
+		return zero, fmt.Errorf(format, args...)
+	}
+	return o.value, nil
+}
+
+// Expect returns the value if present, otherwise panics with msg followed by the reason,
+// for example "loading port: value is absent".
+func (o Option[T]) Expect(msg string) T {
+	if o.state == stateNil {
+		panic(msg + ": value is nil")
+	}
+	if o.state == stateAbsent {
+		panic(msg + ": value is absent")
+	}
+	return o.value
+}
+
+// Unpack returns the value and true if present, otherwise the zero value and false.
+// It enables the idiomatic `if v, ok := opt.Unpack(); ok { ... }` pattern.
+func (o Option[T]) Unpack() (T, bool) {
+	if o.state != statePresent {
+		var zero T
+		return zero, false
+	}
+	return o.value, true
+}
+
+// TakeIf returns the value and true if present and the predicate returns true,
+// otherwise the zero value and false.
+func (o Option[T]) TakeIf(predicate func(T) bool) (T, bool) {
+	if o.state != statePresent || !predicate(o.value) {
+		var zero T
+		return zero, false
+	}
+	return o.value, true
+}
+
 // OrElse returns the value if present, otherwise returns fallback.
 func (o Option[T]) OrElse(fallback T) T {
 	if o.state != statePresent {
@@ -121,6 +171,7 @@ func (o Option[T]) OrElse(fallback T) T {
 }
 
 // OrElseGet returns the value if present, otherwise returns the value given by the supplier.
+// The supplier is only called when the value is absent, so it may be expensive.
 func (o Option[T]) OrElseGet(supplier func() T) T {
 	if o.state != statePresent {
 		return supplier()
@@ -128,6 +179,16 @@ func (o Option[T]) OrElseGet(supplier func() T) T {
 	return o.value
 }
 
+// OrElseLazy returns the value if present, otherwise the value of the lazy default.
+// The default is only computed when the value is absent, and at most once across calls
+// sharing it. It accepts a *lazy.Lazy[T] or any other type with a Get() T method.
+func (o Option[T]) OrElseLazy(lazy interface{ Get() T }) T {
+	if o.state != statePresent {
+		return lazy.Get()
+	}
+	return o.value
+}
+
 // OrElseErr returns the value if present, otherwise returns the error given by the supplier.
 func (o Option[T]) OrElseErr(supplier func() error) (T, error) {
 	if o.state != statePresent {
@@ -214,6 +275,33 @@ func FlatMap[T, U any](o Option[T], mapper func(T) Option[U]) Option[U] {
 	return mapper(o.value)
 }
 
+// ZipWith combines the values of two Options using the provided function.
+// If either Option is None, returns None.
+func ZipWith[A, B, C any](a Option[A], b Option[B], fn func(A, B) C) Option[C] {
+	if a.state != statePresent || b.state != statePresent {
+		return None[C]()
+	}
+	return Some(fn(a.value, b.value))
+}
+
+// ZipWith3 combines the values of three Options using the provided function.
+// If any Option is None, returns None.
+func ZipWith3[A, B, C, D any](a Option[A], b Option[B], c Option[C], fn func(A, B, C) D) Option[D] {
+	if a.state != statePresent || b.state != statePresent || c.state != statePresent {
+		return None[D]()
+	}
+	return Some(fn(a.value, b.value, c.value))
+}
+
+// Apply calls the function held by fn with the value held by o.
+// If either Option is None, returns None.
+func Apply[T, U any](fn Option[func(T) U], o Option[T]) Option[U] {
+	if fn.state != statePresent || o.state != statePresent {
+		return None[U]()
+	}
+	return Some(fn.value(o.value))
+}
+
 // And returns None if the Option is None, otherwise returns other.
 func (o Option[T]) And(other Option[T]) Option[T] {
 	if o.state != statePresent {
@@ -224,7 +312,7 @@ func (o Option[T]) And(other Option[T]) Option[T] {
 
 // Or returns the Option if it contains a value, otherwise returns other.
 func (o Option[T]) Or(other Option[T]) Option[T] {
-	if o.state != statePresent {
+	if o.state == statePresent {
 		return o
 	}
 	return other
@@ -232,8 +320,9 @@ func (o Option[T]) Or(other Option[T]) Option[T] {
 
 // OrElseOption returns the Option if it contains a value,
 // otherwise returns the Option provided by the supplier.
+// The supplier is only called when the value is absent.
 func (o Option[T]) OrElseOption(supplier func() Option[T]) Option[T] {
-	if o.state != statePresent {
+	if o.state == statePresent {
 		return o
 	}
 	return supplier()
@@ -309,3 +398,103 @@ func TryMap[T, U any](o Option[T], mapper func(T) (U, error)) Option[U] {
 	}
 	return Some(result)
 }
+
+// Values returns the values of all present Options, in order, dropping absent ones.
+func Values[T any](opts []Option[T]) []T {
+	values := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if o.state == statePresent {
+			values = append(values, o.value)
+		}
+	}
+	return values
+}
+
+// TraverseSlice applies fn to every element and collects the results.
+// It returns Some with all results if fn returned Some for every element,
+// otherwise None. It stops at the first None.
+func TraverseSlice[T, U any](values []T, fn func(T) Option[U]) Option[[]U] {
+	results := make([]U, 0, len(values))
+	for _, v := range values {
+		o := fn(v)
+		if o.state != statePresent {
+			return None[[]U]()
+		}
+		results = append(results, o.value)
+	}
+	return Some(results)
+}
+
+// FirstSome returns the first present Option, or None if none of them is present.
+func FirstSome[T any](opts ...Option[T]) Option[T] {
+	for _, o := range opts {
+		if o.state == statePresent {
+			return o
+		}
+	}
+	return None[T]()
+}
+
+// Coalesce returns the first Option that is set, either Some or Nil, or None if all of them are None.
+// It is meant for layered configuration, with the options ordered from the highest priority to the lowest:
+// unlike FirstSome, an explicit Nil in a higher layer stops the search and clears the value.
+func Coalesce[T any](opts ...Option[T]) Option[T] {
+	for _, o := range opts {
+		if o.state != stateAbsent {
+			return o
+		}
+	}
+	return None[T]()
+}
+
+// Merge layers override on top of base: it returns override if it is set, either Some or Nil,
+// and base if override is None. An explicit Nil therefore clears the base value, while None keeps it.
+func Merge[T any](base, override Option[T]) Option[T] {
+	if override.state != stateAbsent {
+		return override
+	}
+	return base
+}
+
+// Index returns Some(slice[i]), or None if i is out of range.
+func Index[T any](slice []T, i int) Option[T] {
+	if i < 0 || i >= len(slice) {
+		return None[T]()
+	}
+	return Some(slice[i])
+}
+
+// First returns Some with the first element of the slice, or None if it is empty.
+func First[T any](slice []T) Option[T] {
+	return Index(slice, 0)
+}
+
+// Last returns Some with the last element of the slice, or None if it is empty.
+func Last[T any](slice []T) Option[T] {
+	return Index(slice, len(slice)-1)
+}
+
+// MapGet returns Some with the value stored under key, or None if the key is absent.
+// Unlike a plain index expression, a key mapped to the zero value is distinguished from a missing key.
+func MapGet[K comparable, V any](m map[K]V, key K) Option[V] {
+	value, ok := m[key]
+	return FromPair(value, ok)
+}
+
+// UnwrapAll checks that every given Option is present, which is handy for validating many
+// required settings at startup. opts maps the name of each setting to its Option, so options
+// of different types can be mixed. It returns nil if all are present, otherwise an error
+// naming every absent setting, in sorted order.
+func UnwrapAll(opts map[string]interface{ IsPresent() bool }) error {
+	var missing []string
+	for name, o := range opts {
+		if !o.IsPresent() {
+			missing = append(missing, name)
+		}
+	}
+	if missing == nil {
+		return nil
+	}
+	slices.Sort(missing)
+	return fmt.Errorf("required options are absent: %s", strings.Join(missing, ", "))
+}
@@ -0,0 +1,122 @@
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/marouanesouiri/stdx/lazy"
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+func BenchmarkOrElseGetPresent(b *testing.B) {
+	o := optional.Some(1)
+	calls := 0
+	supplier := func() int {
+		calls++
+		return 0
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = o.OrElseGet(supplier)
+	}
+	if calls != 0 {
+		b.Fatalf("supplier called %d times for a present value", calls)
+	}
+}
+
+func BenchmarkOrElseLazyPresent(b *testing.B) {
+	o := optional.Some(1)
+	calls := 0
+	def := lazy.New(func() int {
+		calls++
+		return 0
+	})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = o.OrElseLazy(&def)
+	}
+	if calls != 0 {
+		b.Fatalf("lazy default computed %d times for a present value", calls)
+	}
+}
+
+func BenchmarkOrElseLazyAbsent(b *testing.B) {
+	o := optional.None[int]()
+	calls := 0
+	def := lazy.New(func() int {
+		calls++
+		return 0
+	})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = o.OrElseLazy(&def)
+	}
+	if calls != 1 {
+		b.Fatalf("expected lazy default computed once, got %d", calls)
+	}
+}
+
+func TestOr(t *testing.T) {
+	fallback := optional.Some(9)
+	tests := []struct {
+		name     string
+		o        optional.Option[int]
+		expected optional.Option[int]
+		calls    int
+	}{
+		{"Some", optional.Some(1), optional.Some(1), 0},
+		{"None", optional.None[int](), fallback, 1},
+		{"Nil", optional.Nil[int](), fallback, 1},
+	}
+	for _, tt := range tests {
+		if got := tt.o.Or(fallback); got != tt.expected {
+			t.Errorf("%s.Or: expected %v, got %v", tt.name, tt.expected, got)
+		}
+
+		calls := 0
+		got := tt.o.OrElseOption(func() optional.Option[int] {
+			calls++
+			return fallback
+		})
+		if got != tt.expected {
+			t.Errorf("%s.OrElseOption: expected %v, got %v", tt.name, tt.expected, got)
+		}
+		if calls != tt.calls {
+			t.Errorf("%s.OrElseOption: expected %d supplier calls, got %d", tt.name, tt.calls, calls)
+		}
+	}
+}
+
+func TestIsAbsent(t *testing.T) {
+	if optional.Some[*int](nil).IsAbsent() {
+		t.Error("expected Some(nil) to be present")
+	}
+	if optional.Some(0).IsAbsent() {
+		t.Error("expected Some(0) to be present")
+	}
+	if !optional.None[*int]().IsAbsent() {
+		t.Error("expected None to be absent")
+	}
+	if !optional.Nil[*int]().IsAbsent() {
+		t.Error("expected Nil to be absent")
+	}
+}
+
+func TestUnwrapAll(t *testing.T) {
+	host, port, token := optional.None[string](), optional.Some(8080), optional.Nil[string]()
+	err := optional.UnwrapAll(map[string]interface{ IsPresent() bool }{
+		"token": token,
+		"port":  port,
+		"host":  host,
+	})
+	if err == nil || err.Error() != "required options are absent: host, token" {
+		t.Errorf("expected the absent options to be named, got %v", err)
+	}
+
+	err = optional.UnwrapAll(map[string]interface{ IsPresent() bool }{"port": port})
+	if err != nil {
+		t.Errorf("expected nil when every option is present, got %v", err)
+	}
+}
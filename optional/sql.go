@@ -0,0 +1,36 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner.
+// SQL NULL is scanned as None, any other value is converted into T and scanned as Some.
+// Conversion follows the rules of database/sql, so T may be any type sql.Rows.Scan
+// accepts, including custom types that implement sql.Scanner themselves.
+func (o *Option[T]) Scan(src any) error {
+	if src == nil {
+		*o = None[T]()
+		return nil
+	}
+
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+
+	*o = Some(n.V)
+	return nil
+}
+
+// Value implements driver.Valuer.
+// Absent values are written as SQL NULL.
+// Present values are converted with the database/sql default rules,
+// so custom types that implement driver.Valuer are honored.
+func (o Option[T]) Value() (driver.Value, error) {
+	if o.state != statePresent {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
@@ -0,0 +1,53 @@
+package optional_test
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// cents is a custom type that implements driver.Valuer.
+type cents int
+
+func (c cents) Value() (driver.Value, error) {
+	return int64(c) * 100, nil
+}
+
+func TestScan(t *testing.T) {
+	o := optional.Some(1)
+	if err := o.Scan(nil); err != nil || o.IsPresent() {
+		t.Errorf("expected NULL to scan as None, got %v, %v", o, err)
+	}
+	if err := o.Scan(int64(42)); err != nil || o != optional.Some(42) {
+		t.Errorf("expected Some(42), got %v, %v", o, err)
+	}
+	if err := o.Scan([]byte("7")); err != nil || o != optional.Some(7) {
+		t.Errorf("expected bytes to be converted to Some(7), got %v, %v", o, err)
+	}
+
+	var s optional.Option[string]
+	if err := s.Scan("gopher"); err != nil || s != optional.Some("gopher") {
+		t.Errorf("expected Some(gopher), got %v, %v", s, err)
+	}
+
+	if err := o.Scan("not a number"); err == nil {
+		t.Errorf("expected a conversion failure, got %v", o)
+	}
+}
+
+func TestValue(t *testing.T) {
+	if v, err := optional.None[int]().Value(); err != nil || v != nil {
+		t.Errorf("expected None to be NULL, got %v, %v", v, err)
+	}
+	if v, err := optional.Some(42).Value(); err != nil || v != int64(42) {
+		t.Errorf("expected int64(42), got %v (%T), %v", v, v, err)
+	}
+	if v, err := optional.Some(cents(3)).Value(); err != nil || v != int64(300) {
+		t.Errorf("expected the custom Valuer to be honored, got %v, %v", v, err)
+	}
+	if _, err := optional.Some(struct{ At time.Time }{}).Value(); err == nil {
+		t.Error("expected a struct to fail conversion")
+	}
+}
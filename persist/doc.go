@@ -0,0 +1,46 @@
+/*
+Package persist snapshots stdx containers to a compact binary format and restores them,
+enabling warm restarts of in-memory state without writing a serializer per container.
+
+Every supported container is wrapped in a Snapshotter:
+
+	persist.Set(&s)           // set.Set
+	persist.OrderedMap(&m)    // omap.OrderedMap, insertion order preserved
+	persist.Multimap(&mm)     // mmap.Multimap
+	persist.Deque(&d)         // deque.Deque, element order preserved
+	persist.ConcurrentMap(&c) // cmap.ConcurrentMap
+
+Example usage:
+
+	sessions := cmap.New[string, Session]()
+
+	// On shutdown
+	if err := persist.SaveFile("sessions.snap", persist.ConcurrentMap(&sessions)); err != nil {
+		log.Print(err)
+	}
+
+	// On startup
+	if err := persist.LoadFile("sessions.snap", persist.ConcurrentMap(&sessions)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Print(err)
+	}
+
+SaveFile writes to a temporary file and renames it, so a crash never leaves a half-written snapshot.
+Snapshot and Restore work with any io.Writer and io.Reader for other destinations.
+
+# Format
+
+A snapshot starts with a 6-byte header (magic "STDX", format version, container kind),
+followed by an encoding/gob stream holding the element count and the elements.
+Type information is written once per snapshot, so elements are stored compactly.
+Element types must therefore be encodable with encoding/gob.
+
+Restore fails with ErrFormat for data that is not a snapshot, and with ErrKind
+for a snapshot taken from a different kind of container. On error, the container is left unchanged.
+
+# Consistency
+
+Snapshots of a ConcurrentMap are taken shard by shard; writes made during Snapshot may or may
+not be included. The other containers are not thread-safe and must not be modified during
+Snapshot or Restore.
+*/
+package persist
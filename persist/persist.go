@@ -0,0 +1,392 @@
+package persist
+
+import (
+	"bufio"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/marouanesouiri/stdx/cmap"
+	"github.com/marouanesouiri/stdx/deque"
+	"github.com/marouanesouiri/stdx/mmap"
+	"github.com/marouanesouiri/stdx/omap"
+	"github.com/marouanesouiri/stdx/set"
+)
+
+// Snapshotter is implemented by containers that can write their contents to a stream
+// and later be restored from it.
+type Snapshotter interface {
+	// Snapshot writes the current contents to w.
+	Snapshot(w io.Writer) error
+	// Restore replaces the current contents with those read from r.
+	Restore(r io.Reader) error
+}
+
+// ErrFormat is returned by Restore when the data is not a snapshot produced by this package.
+var ErrFormat = errors.New("persist: invalid snapshot format")
+
+// ErrKind is returned by Restore when the snapshot was taken from a different kind of container.
+var ErrKind = errors.New("persist: snapshot is for a different container kind")
+
+const version = 1
+
+// maxPrealloc bounds the capacity reserved up front from a snapshot's element count,
+// which comes from untrusted data; larger snapshots grow as their elements are decoded.
+const maxPrealloc = 1024
+
+// kind identifies the container a snapshot was taken from.
+type kind byte
+
+const (
+	kindSet kind = iota + 1
+	kindOrderedMap
+	kindMultimap
+	kindDeque
+	kindConcurrentMap
+)
+
+var magic = [4]byte{'S', 'T', 'D', 'X'}
+
+// writer encodes a snapshot: a fixed header followed by a gob stream holding the
+// element count and then every element. Type information is sent once per stream,
+// so each element costs only its own encoded bytes.
+type writer struct {
+	buf *bufio.Writer
+	enc *gob.Encoder
+}
+
+func newWriter(w io.Writer, k kind, count int) (*writer, error) {
+	buf := bufio.NewWriter(w)
+	header := append(magic[:], version, byte(k))
+	if _, err := buf.Write(header); err != nil {
+		return nil, err
+	}
+	enc := gob.NewEncoder(buf)
+	if err := enc.Encode(count); err != nil {
+		return nil, err
+	}
+	return &writer{buf: buf, enc: enc}, nil
+}
+
+func (w *writer) encode(v any) error {
+	return w.enc.Encode(v)
+}
+
+func (w *writer) flush() error {
+	return w.buf.Flush()
+}
+
+// reader decodes a snapshot written by writer.
+type reader struct {
+	dec   *gob.Decoder
+	count int
+}
+
+func newReader(r io.Reader, k kind) (*reader, error) {
+	buf := bufio.NewReader(r)
+	var header [6]byte
+	if _, err := io.ReadFull(buf, header[:]); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFormat, err)
+	}
+	if [4]byte(header[:4]) != magic || header[4] != version {
+		return nil, ErrFormat
+	}
+	if kind(header[5]) != k {
+		return nil, ErrKind
+	}
+	dec := gob.NewDecoder(buf)
+	rd := &reader{dec: dec}
+	if err := dec.Decode(&rd.count); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFormat, err)
+	}
+	if rd.count < 0 {
+		return nil, fmt.Errorf("%w: negative element count %d", ErrFormat, rd.count)
+	}
+	return rd, nil
+}
+
+// decode reads the next value. A snapshot that ends early or holds values of the wrong
+// type is reported as ErrFormat.
+func (r *reader) decode(v any) error {
+	if err := r.dec.Decode(v); err != nil {
+		return fmt.Errorf("%w: %w", ErrFormat, err)
+	}
+	return nil
+}
+
+// capacity returns the capacity to reserve for the snapshot's elements.
+func (r *reader) capacity() int {
+	return min(r.count, maxPrealloc)
+}
+
+type setSnapshotter[T comparable] struct {
+	s *set.Set[T]
+}
+
+// Set returns a Snapshotter for a set.Set.
+func Set[T comparable](s *set.Set[T]) Snapshotter {
+	return setSnapshotter[T]{s: s}
+}
+
+func (p setSnapshotter[T]) Snapshot(w io.Writer) error {
+	out, err := newWriter(w, kindSet, p.s.Size())
+	if err != nil {
+		return err
+	}
+	for item := range p.s.Seq() {
+		if err = out.encode(&item); err != nil {
+			return err
+		}
+	}
+	return out.flush()
+}
+
+func (p setSnapshotter[T]) Restore(r io.Reader) error {
+	in, err := newReader(r, kindSet)
+	if err != nil {
+		return err
+	}
+	restored := set.New[T]()
+	for range in.count {
+		var item T
+		if err := in.decode(&item); err != nil {
+			return err
+		}
+		restored.Add(item)
+	}
+	*p.s = restored
+	return nil
+}
+
+type orderedMapSnapshotter[K comparable, V any] struct {
+	m *omap.OrderedMap[K, V]
+}
+
+// OrderedMap returns a Snapshotter for an omap.OrderedMap. Insertion order is preserved,
+// and restoring keeps the map's options, such as WithStableOrder.
+func OrderedMap[K comparable, V any](m *omap.OrderedMap[K, V]) Snapshotter {
+	return orderedMapSnapshotter[K, V]{m: m}
+}
+
+func (p orderedMapSnapshotter[K, V]) Snapshot(w io.Writer) error {
+	out, err := newWriter(w, kindOrderedMap, p.m.Len())
+	if err != nil {
+		return err
+	}
+	p.m.Range(func(key K, value V) bool {
+		if err = out.encode(&key); err == nil {
+			err = out.encode(&value)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	return out.flush()
+}
+
+func (p orderedMapSnapshotter[K, V]) Restore(r io.Reader) error {
+	in, err := newReader(r, kindOrderedMap)
+	if err != nil {
+		return err
+	}
+	items := make([]omap.Item[K, V], 0, in.capacity())
+	for range in.count {
+		var item omap.Item[K, V]
+		if err := in.decode(&item.Key); err != nil {
+			return err
+		}
+		if err := in.decode(&item.Value); err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	p.m.Clear()
+	for _, item := range items {
+		p.m.Set(item.Key, item.Value)
+	}
+	return nil
+}
+
+type multimapSnapshotter[K comparable, V comparable] struct {
+	m *mmap.Multimap[K, V]
+}
+
+// Multimap returns a Snapshotter for an mmap.Multimap.
+// Restoring keeps the multimap's options, such as its per-key value cap.
+func Multimap[K comparable, V comparable](m *mmap.Multimap[K, V]) Snapshotter {
+	return multimapSnapshotter[K, V]{m: m}
+}
+
+func (p multimapSnapshotter[K, V]) Snapshot(w io.Writer) error {
+	out, err := newWriter(w, kindMultimap, p.m.Size())
+	if err != nil {
+		return err
+	}
+	p.m.Range(func(key K, value V) bool {
+		if err = out.encode(&key); err == nil {
+			err = out.encode(&value)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	return out.flush()
+}
+
+func (p multimapSnapshotter[K, V]) Restore(r io.Reader) error {
+	in, err := newReader(r, kindMultimap)
+	if err != nil {
+		return err
+	}
+	keys := make([]K, 0, in.capacity())
+	values := make([]V, 0, in.capacity())
+	for range in.count {
+		var key K
+		var value V
+		if err := in.decode(&key); err != nil {
+			return err
+		}
+		if err := in.decode(&value); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	p.m.Clear()
+	for i := range keys {
+		p.m.Put(keys[i], values[i])
+	}
+	return nil
+}
+
+type dequeSnapshotter[T any] struct {
+	d *deque.Deque[T]
+}
+
+// Deque returns a Snapshotter for a deque.Deque. Element order is preserved.
+func Deque[T any](d *deque.Deque[T]) Snapshotter {
+	return dequeSnapshotter[T]{d: d}
+}
+
+func (p dequeSnapshotter[T]) Snapshot(w io.Writer) error {
+	out, err := newWriter(w, kindDeque, p.d.Len())
+	if err != nil {
+		return err
+	}
+	p.d.Range(func(item T) bool {
+		err = out.encode(&item)
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	return out.flush()
+}
+
+func (p dequeSnapshotter[T]) Restore(r io.Reader) error {
+	in, err := newReader(r, kindDeque)
+	if err != nil {
+		return err
+	}
+	restored := deque.New[T](in.capacity())
+	for range in.count {
+		var item T
+		if err := in.decode(&item); err != nil {
+			return err
+		}
+		restored.PushBack(item)
+	}
+	*p.d = restored
+	return nil
+}
+
+type concurrentMapSnapshotter[K comparable, V any] struct {
+	m *cmap.ConcurrentMap[K, V]
+}
+
+// ConcurrentMap returns a Snapshotter for a cmap.ConcurrentMap.
+//
+// The snapshot is taken shard by shard, so writes made concurrently with Snapshot
+// may or may not be included. Restore decodes the whole snapshot first and then
+// replaces the contents; it is not atomic with respect to concurrent readers.
+func ConcurrentMap[K comparable, V any](m *cmap.ConcurrentMap[K, V]) Snapshotter {
+	return concurrentMapSnapshotter[K, V]{m: m}
+}
+
+func (p concurrentMapSnapshotter[K, V]) Snapshot(w io.Writer) error {
+	items := p.m.Items()
+	out, err := newWriter(w, kindConcurrentMap, len(items))
+	if err != nil {
+		return err
+	}
+	for i := range items {
+		if err := out.encode(&items[i].Key); err != nil {
+			return err
+		}
+		if err := out.encode(&items[i].Value); err != nil {
+			return err
+		}
+	}
+	return out.flush()
+}
+
+func (p concurrentMapSnapshotter[K, V]) Restore(r io.Reader) error {
+	in, err := newReader(r, kindConcurrentMap)
+	if err != nil {
+		return err
+	}
+	items := make([]cmap.Item[K, V], 0, in.capacity())
+	for range in.count {
+		var item cmap.Item[K, V]
+		if err := in.decode(&item.Key); err != nil {
+			return err
+		}
+		if err := in.decode(&item.Value); err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	p.m.Clear()
+	for _, item := range items {
+		p.m.Set(item.Key, item.Value)
+	}
+	return nil
+}
+
+// SaveFile writes a snapshot to path atomically: the data is written to a temporary
+// file in the same directory and renamed over path once complete.
+func SaveFile(path string, s Snapshotter) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := s.Snapshot(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadFile restores s from a snapshot file written by SaveFile.
+func LoadFile(path string, s Snapshotter) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return s.Restore(f)
+}
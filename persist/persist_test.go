@@ -0,0 +1,154 @@
+package persist
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/marouanesouiri/stdx/cmap"
+	"github.com/marouanesouiri/stdx/deque"
+	"github.com/marouanesouiri/stdx/mmap"
+	"github.com/marouanesouiri/stdx/omap"
+	"github.com/marouanesouiri/stdx/set"
+)
+
+func roundTrip(t *testing.T, from, to Snapshotter) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := from.Snapshot(&buf); err != nil {
+		t.Fatalf("snapshot failed: %v", err)
+	}
+	if err := to.Restore(&buf); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := set.FromSlice([]int{0, 1, 2, 3})
+	restored := set.New[int]()
+	roundTrip(t, Set(&s), Set(&restored))
+	if !restored.Equal(s) {
+		t.Errorf("expected %v, got %v", s.String(), restored.String())
+	}
+}
+
+func TestOrderedMap(t *testing.T) {
+	m := omap.New[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 0)
+	restored := omap.New[string, int]()
+	restored.Set("stale", 9)
+	roundTrip(t, OrderedMap(&m), OrderedMap(&restored))
+	keys := restored.Keys()
+	if len(keys) != 3 || keys[0] != "c" || keys[1] != "a" || keys[2] != "b" {
+		t.Errorf("expected keys [c a b], got %v", keys)
+	}
+	if restored.Get("b").Get() != 0 || !restored.Has("b") {
+		t.Error("expected b to be restored with value 0")
+	}
+
+	stable := omap.New(omap.WithStableOrder[string, int]())
+	roundTrip(t, OrderedMap(&m), OrderedMap(&stable))
+	stable.Set("c", 30)
+	if keys := stable.Keys(); keys[0] != "c" {
+		t.Errorf("expected restore to keep stable order, got %v", keys)
+	}
+}
+
+func TestMultimap(t *testing.T) {
+	m := mmap.New[string, int]()
+	m.PutAll("a", 1, 2)
+	m.Put("b", 3)
+	restored := mmap.New[string, int]()
+	roundTrip(t, Multimap(&m), Multimap(&restored))
+	if restored.Size() != 3 || !restored.Contains("a", 2) || !restored.Contains("b", 3) {
+		t.Errorf("unexpected multimap after restore: %v", restored.String())
+	}
+}
+
+func TestDeque(t *testing.T) {
+	d := deque.New[string](0)
+	d.PushBack("b")
+	d.PushFront("a")
+	d.PushBack("c")
+	restored := deque.New[string](0)
+	roundTrip(t, Deque(&d), Deque(&restored))
+	var got []string
+	restored.Range(func(s string) bool {
+		got = append(got, s)
+		return true
+	})
+	if len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Errorf("expected [a b c], got %v", got)
+	}
+}
+
+func TestConcurrentMap(t *testing.T) {
+	m := cmap.New[int, []string]()
+	for i := range 100 {
+		m.Set(i, []string{"x"})
+	}
+	restored := cmap.New[int, []string]()
+	path := filepath.Join(t.TempDir(), "snap")
+	if err := SaveFile(path, ConcurrentMap(&m)); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadFile(path, ConcurrentMap(&restored)); err != nil {
+		t.Fatal(err)
+	}
+	if restored.Len() != 100 {
+		t.Errorf("expected 100 entries, got %d", restored.Len())
+	}
+}
+
+func TestRestoreErrors(t *testing.T) {
+	s := set.FromSlice([]int{1})
+	var buf bytes.Buffer
+	if err := Set(&s).Snapshot(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d := deque.New[int](0)
+	if err := Deque(&d).Restore(bytes.NewReader(buf.Bytes())); !errors.Is(err, ErrKind) {
+		t.Errorf("expected ErrKind, got %v", err)
+	}
+	if err := Set(&s).Restore(bytes.NewReader([]byte("garbage"))); !errors.Is(err, ErrFormat) {
+		t.Errorf("expected ErrFormat, got %v", err)
+	}
+}
+
+func TestRestoreTamperedCount(t *testing.T) {
+	for _, count := range []int{-1, 1 << 40} {
+		// A header whose element count was overwritten, followed by a single entry.
+		var buf bytes.Buffer
+		out, err := newWriter(&buf, kindConcurrentMap, count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, value := "a", 1
+		if err := out.encode(&key); err != nil {
+			t.Fatal(err)
+		}
+		if err := out.encode(&value); err != nil {
+			t.Fatal(err)
+		}
+		if err := out.flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		restored := cmap.New[string, int]()
+		restored.Set("kept", 1)
+		if err := ConcurrentMap(&restored).Restore(bytes.NewReader(buf.Bytes())); !errors.Is(err, ErrFormat) {
+			t.Errorf("count %d: expected ErrFormat, got %v", count, err)
+		}
+		if restored.Len() != 1 || !restored.Has("kept") {
+			t.Errorf("count %d: expected a failed Restore to leave the map unchanged, got %v", count, restored.Items())
+		}
+
+		d := deque.New[int](0)
+		if err := Deque(&d).Restore(bytes.NewReader(buf.Bytes())); !errors.Is(err, ErrKind) {
+			t.Errorf("count %d: expected ErrKind, got %v", count, err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package result
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error stored in a Result produced by Catch, CatchErr or Guard when the function panicked.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine at the time of the panic.
+	Stack []byte
+}
+
+// Error returns the panic value followed by the stack trace.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the panic value if it is an error, so errors.Is and errors.As see through the panic.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Catch calls fn and returns its value as Ok.
+// If fn panics, the panic is recovered and returned as an Err holding a *PanicError.
+func Catch[T any](fn func() T) (r Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = Err[T](&PanicError{Value: v, Stack: debug.Stack()})
+		}
+	}()
+	return Ok(fn())
+}
+
+// CatchErr calls fn and converts its (value, error) pair into a Result.
+// If fn panics, the panic is recovered and returned as an Err holding a *PanicError.
+func CatchErr[T any](fn func() (T, error)) (r Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = Err[T](&PanicError{Value: v, Stack: debug.Stack()})
+		}
+	}()
+	return From(fn())
+}
+
+// Guard calls fn and returns its Result.
+// If fn panics, the panic is recovered and returned as an Err holding a *PanicError,
+// so a single call site becomes a boundary that never lets a panic escape.
+func Guard[T any](fn func() Result[T]) Result[T] {
+	r := Catch(fn)
+	if r.IsErr() {
+		return Err[T](r.Err())
+	}
+	return r.Value()
+}
@@ -25,6 +25,60 @@ Safe usage:
 	val := r.UnwrapOr(0)
 	val := r.UnwrapOrElse(func() int { return calculateDefault() })
 
+Matching errors:
+
+	if r.ErrIs(fs.ErrNotExist) {
+		// ...
+	}
+
+	if pathErr, ok := result.ErrAs[*fs.PathError](r).Unpack(); ok {
+		fmt.Println(pathErr.Path)
+	}
+
+Transformations:
+
+	// Map and FlatMap change the value type, Err passes through untouched
+	n := result.Map(r, func(s string) int { return len(s) })
+	user := result.FlatMap(result.From(strconv.Atoi(id)), loadUser)
+
+	// AndThen chains steps of the same type
+	cfg := loadConfig().AndThen(validate).AndThen(applyDefaults)
+
+	// MapErr adds context to errors
+	r = r.MapErr(func(err error) error { return fmt.Errorf("loading config: %w", err) })
+
+	// ZipWith combines two Results
+	sum := result.ZipWith(a, b, func(x, y int) int { return x + y })
+
+Working with slices:
+
+	results := fetchAll(urls) // []result.Result[Page]
+
+	pages := result.Collect(results)    // first error, or Ok with all pages
+	all := result.CollectAll(results)   // every error joined with errors.Join
+	ok, errs := result.Partition(results)
+
+Recovering panics:
+
+	// A panic in the callback becomes an Err holding a *result.PanicError with the stack trace
+	r := result.Catch(func() int { return untrusted(job) })
+	r2 := result.CatchErr(func() (Page, error) { return plugin.Fetch(url) })
+
+	// Guard is the same panic boundary for any function returning a Result, e.g. a gRPC method
+	reply := result.Guard(func() result.Result[*pb.Reply] { return handle(ctx, req) })
+
+To serve functions returning a Result over HTTP, see package httpresult.
+
+Retrying:
+
+	// Up to 5 attempts, waiting 100ms, 200ms, 400ms, ... (at most 2s) between them
+	page := result.Retry(5, result.ExponentialBackoff(100*time.Millisecond, 2*time.Second), func() result.Result[Page] {
+		return result.From(fetch(url))
+	})
+
+	// Stop early on errors that will not go away, and when ctx is cancelled
+	page = result.RetryCtx(ctx, 5, backoff, isTemporary, fetchPage)
+
 Interop:
 
 	// Convert back to (T, error)
@@ -32,5 +86,22 @@ Interop:
 
 	// Convert to Option
 	opt := r.Option() // Some(val) or None
+
+	// Convert to and from either.Either
+	e := result.ToEither[error](r)         // Either[error, T]
+	typed := result.ToEither[*MyError](r)  // Either[*MyError, T], panics on other error types
+	back := result.FromEither(e)           // a nil Left becomes Err(result.ErrNilLeft)
+
+Resource scoping:
+
+	// release runs after use, whatever the outcome
+	lines := result.Using(
+		func() (*os.File, error) { return os.Open("file.txt") },
+		func(f *os.File) result.Result[int] { return countLines(f) },
+		func(f *os.File) { f.Close() },
+	)
+
+	// Finally runs a side effect and passes the Result through
+	r = r.Finally(func() { metrics.Done() })
 */
 package result
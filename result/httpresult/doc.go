@@ -0,0 +1,19 @@
+// Package httpresult serves functions returning a result.Result over HTTP.
+//
+// It is kept apart from package result so that using Result does not pull in net/http.
+//
+// Handler writes an Ok value as JSON with status 200 and an Err as {"error": message}.
+// The status of an Err comes from the first *StatusError in its chain, created with WithStatus,
+// and defaults to 500. Panics are recovered with result.Guard and answered with 500:
+//
+//	http.Handle("/users/{id}", httpresult.Handler(func(r *http.Request) result.Result[User] {
+//	    id, err := strconv.Atoi(r.PathValue("id"))
+//	    if err != nil {
+//	        return result.Err[User](httpresult.WithStatus(http.StatusBadRequest, err))
+//	    }
+//	    return result.From(store.User(id))
+//	}, httpresult.WithErrorHook(logError)))
+//
+// For 5xx statuses the response only carries the standard status text, so internal error
+// details are not exposed; use WithErrorHook to log them.
+package httpresult
@@ -0,0 +1,93 @@
+package httpresult
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/marouanesouiri/stdx/result"
+)
+
+// StatusError attaches an HTTP status code to an error.
+// Handler uses it to pick the response status of an Err.
+type StatusError struct {
+	Code int
+	Err  error
+}
+
+// WithStatus wraps err in a *StatusError with the given HTTP status code.
+func WithStatus(code int, err error) error {
+	return &StatusError{Code: code, Err: err}
+}
+
+// Error returns the message of the wrapped error.
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// HandlerOption configures a Handler.
+type HandlerOption func(*handlerConfig)
+
+type handlerConfig struct {
+	onError func(*http.Request, error)
+}
+
+// WithErrorHook sets a function called with every Err returned by the handler, including recovered panics,
+// before the error response is written. Use it to log errors that clients only see as a status code.
+func WithErrorHook(fn func(r *http.Request, err error)) HandlerOption {
+	return func(c *handlerConfig) {
+		c.onError = fn
+	}
+}
+
+// Handler adapts a function returning a Result into an http.Handler.
+//
+// An Ok value is written as JSON with status 200. An Err is written as a JSON object
+// {"error": message} with the status of the first *StatusError in its chain, or 500 if there is none.
+// For 5xx statuses the message is the standard status text, so internal error details are not exposed.
+// Panics in fn are recovered with result.Guard and answered with 500.
+func Handler[T any](fn func(r *http.Request) result.Result[T], opts ...HandlerOption) http.Handler {
+	var cfg handlerConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		res := result.Guard(func() result.Result[T] { return fn(req) })
+		if res.IsOk() {
+			writeJSON(w, http.StatusOK, res.Value())
+			return
+		}
+
+		err := res.Err()
+		if cfg.onError != nil {
+			cfg.onError(req, err)
+		}
+		code := http.StatusInternalServerError
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) {
+			code = statusErr.Code
+		}
+		message := err.Error()
+		if code >= http.StatusInternalServerError {
+			message = http.StatusText(code)
+		}
+		writeJSON(w, code, map[string]string{"error": message})
+	})
+}
+
+// writeJSON writes v as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		code = http.StatusInternalServerError
+		body = []byte(`{"error":"Internal Server Error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(body)
+}
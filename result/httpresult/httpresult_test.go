@@ -0,0 +1,83 @@
+package httpresult
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/marouanesouiri/stdx/result"
+)
+
+type user struct {
+	Name string `json:"name"`
+}
+
+func serve(t *testing.T, h http.Handler) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected Content-Type application/json, got %q", ct)
+	}
+	return rec
+}
+
+func TestHandlerOk(t *testing.T) {
+	rec := serve(t, Handler(func(*http.Request) result.Result[user] {
+		return result.Ok(user{Name: "gopher"})
+	}))
+	if rec.Code != http.StatusOK || rec.Body.String() != `{"name":"gopher"}` {
+		t.Errorf("expected 200 {\"name\":\"gopher\"}, got %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestHandlerErr(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		code     int
+		expected string
+	}{
+		{"StatusError", WithStatus(http.StatusNotFound, errors.New("no such user")), http.StatusNotFound, `{"error":"no such user"}`},
+		{"Wrapped", errors.Join(errors.New("lookup"), WithStatus(http.StatusBadRequest, errors.New("bad id"))), http.StatusBadRequest, `{"error":"lookup\nbad id"}`},
+		{"Plain", errors.New("database is down"), http.StatusInternalServerError, `{"error":"Internal Server Error"}`},
+		{"Hidden5xx", WithStatus(http.StatusBadGateway, errors.New("upstream secret")), http.StatusBadGateway, `{"error":"Bad Gateway"}`},
+	}
+	for _, tt := range tests {
+		rec := serve(t, Handler(func(*http.Request) result.Result[user] {
+			return result.Err[user](tt.err)
+		}))
+		if rec.Code != tt.code || rec.Body.String() != tt.expected {
+			t.Errorf("%s: expected %d %s, got %d %s", tt.name, tt.code, tt.expected, rec.Code, rec.Body)
+		}
+	}
+}
+
+func TestHandlerPanic(t *testing.T) {
+	var hooked error
+	rec := serve(t, Handler(func(*http.Request) result.Result[user] {
+		panic("boom")
+	}, WithErrorHook(func(r *http.Request, err error) {
+		if r.URL.Path != "/users/1" {
+			t.Errorf("expected the hook to receive the request, got %s", r.URL.Path)
+		}
+		hooked = err
+	})))
+	if rec.Code != http.StatusInternalServerError || rec.Body.String() != `{"error":"Internal Server Error"}` {
+		t.Errorf("expected 500, got %d %s", rec.Code, rec.Body)
+	}
+	var panicErr *result.PanicError
+	if !errors.As(hooked, &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("expected the hook to receive the recovered panic, got %v", hooked)
+	}
+}
+
+func TestHandlerUnencodableValue(t *testing.T) {
+	rec := serve(t, Handler(func(*http.Request) result.Result[chan int] {
+		return result.Ok(make(chan int))
+	}))
+	if rec.Code != http.StatusInternalServerError || rec.Body.String() != `{"error":"Internal Server Error"}` {
+		t.Errorf("expected 500 for a value that cannot be encoded, got %d %s", rec.Code, rec.Body)
+	}
+}
@@ -1,8 +1,10 @@
 package result
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/marouanesouiri/stdx/either"
 	"github.com/marouanesouiri/stdx/optional"
 )
 
@@ -147,6 +149,53 @@ func (r Result[T]) Filter(predicate func(T) bool, ifFalseErr error) Result[T] {
 	return r
 }
 
+// Map transforms the value of an Ok Result using the provided function.
+// An Err Result is returned unchanged.
+func Map[T, U any](r Result[T], fn func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.value))
+}
+
+// FlatMap applies a function that returns a Result to the value of an Ok Result.
+// An Err Result is returned unchanged.
+func FlatMap[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return fn(r.value)
+}
+
+// AndThen chains an operation that keeps the value type, calling fn only if the Result is Ok.
+func (r Result[T]) AndThen(fn func(T) Result[T]) Result[T] {
+	if r.err != nil {
+		return r
+	}
+	return fn(r.value)
+}
+
+// MapErr transforms the error of an Err Result, for example to wrap it with context.
+// An Ok Result is returned unchanged.
+func (r Result[T]) MapErr(fn func(error) error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Err[T](fn(r.err))
+}
+
+// ZipWith combines the values of two Ok Results using the provided function.
+// If either Result is Err, the first error is returned.
+func ZipWith[A, B, C any](a Result[A], b Result[B], fn func(A, B) C) Result[C] {
+	if a.err != nil {
+		return Err[C](a.err)
+	}
+	if b.err != nil {
+		return Err[C](b.err)
+	}
+	return Ok(fn(a.value, b.value))
+}
+
 // Option converts the Result to an optional.Option.
 // If Result is Ok, it returns Some(value). If Err, it returns None.
 func (r Result[T]) Option() optional.Option[T] {
@@ -156,6 +205,59 @@ func (r Result[T]) Option() optional.Option[T] {
 	return optional.Some(r.value)
 }
 
+// ErrAs returns the first error in the Result's error chain that matches type E, as found by errors.As.
+// It returns None if the Result is Ok or no error in the chain matches.
+func ErrAs[E error, T any](r Result[T]) optional.Option[E] {
+	if r.err == nil {
+		return optional.None[E]()
+	}
+	var target E
+	if errors.As(r.err, &target) {
+		return optional.Some(target)
+	}
+	return optional.None[E]()
+}
+
+// ErrIs reports whether the Result is Err and any error in its chain matches target, as found by errors.Is.
+func (r Result[T]) ErrIs(target error) bool {
+	return r.err != nil && errors.Is(r.err, target)
+}
+
+// ToEither converts a Result into an Either with the error on the left and the value on the right.
+// The stored error is converted to E by type assertion, falling back to errors.As to search the wrapped chain.
+// Instantiate with E = error for a conversion that always succeeds.
+//
+// Panics if the Result is Err and its error cannot be converted to E.
+func ToEither[E error, T any](r Result[T]) either.Either[E, T] {
+	if r.err == nil {
+		return either.Right[E](r.value)
+	}
+	if e, ok := r.err.(E); ok {
+		return either.Left[E, T](e)
+	}
+	var target E
+	if errors.As(r.err, &target) {
+		return either.Left[E, T](target)
+	}
+	panic(fmt.Sprintf("result.ToEither: error %v (%T) is not of type %T", r.err, r.err, target))
+}
+
+// ErrNilLeft is the error of a Result converted by FromEither from a Left holding a nil error.
+var ErrNilLeft = errors.New("result: Left holds a nil error")
+
+// FromEither converts an Either with an error on the left into a Result.
+// A Left becomes Err and a Right becomes Ok. A Left holding a nil error becomes Err with ErrNilLeft,
+// so the conversion never turns a failure into a success.
+func FromEither[T any](e either.Either[error, T]) Result[T] {
+	if err, ok := e.GetLeft(); ok {
+		if err == nil {
+			err = ErrNilLeft
+		}
+		return Err[T](err)
+	}
+	return Ok(e.Right())
+}
+
 // Recover returns the value if Ok, otherwise handles the error with the provided function and returns its result.
 func (r Result[T]) Recover(fn func(error) T) T {
 	if r.err != nil {
@@ -164,6 +266,62 @@ func (r Result[T]) Recover(fn func(error) T) T {
 	return r.value
 }
 
+// Finally runs fn regardless of whether the Result is Ok or Err, and returns the Result unchanged.
+func (r Result[T]) Finally(fn func()) Result[T] {
+	fn()
+	return r
+}
+
+// Using acquires a resource, passes it to use, and releases it once use returns, even if use panics.
+// If acquire fails, its error is returned as Err and neither use nor release is called.
+func Using[T, U any](acquire func() (T, error), use func(T) Result[U], release func(T)) Result[U] {
+	resource, err := acquire()
+	if err != nil {
+		return Err[U](err)
+	}
+	defer release(resource)
+	return use(resource)
+}
+
+// Collect combines a slice of Results into a single Result of a slice.
+// It returns the first error encountered, or Ok with all values in order.
+func Collect[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			return Err[[]T](r.err)
+		}
+		values = append(values, r.value)
+	}
+	return Ok(values)
+}
+
+// CollectAll combines a slice of Results into a single Result of a slice.
+// Unlike Collect it does not stop at the first error: if any Result is Err,
+// it returns an Err joining every error with errors.Join.
+func CollectAll[T any](results []Result[T]) Result[[]T] {
+	values, errs := Partition(results)
+	if len(errs) > 0 {
+		return Err[[]T](errors.Join(errs...))
+	}
+	return Ok(values)
+}
+
+// Partition splits a slice of Results into the values of the Ok ones and the errors of the Err ones,
+// preserving the relative order of each side.
+func Partition[T any](results []Result[T]) ([]T, []error) {
+	values := make([]T, 0, len(results))
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		values = append(values, r.value)
+	}
+	return values, errs
+}
+
 // Void is a Result that contains no value.
 // It is used for operations that can fail but don't return data on success.
 type Void struct {
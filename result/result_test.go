@@ -0,0 +1,74 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/marouanesouiri/stdx/either"
+)
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestToEither(t *testing.T) {
+	if v, ok := ToEither[error](Ok(1)).GetRight(); !ok || v != 1 {
+		t.Errorf("expected Right(1), got %v, %v", v, ok)
+	}
+
+	direct := &codeError{code: 1}
+	if e, ok := ToEither[*codeError](Err[int](direct)).GetLeft(); !ok || e != direct {
+		t.Errorf("expected Left with the stored error, got %v, %v", e, ok)
+	}
+
+	wrapped := &codeError{code: 2}
+	r := Err[int](fmt.Errorf("request failed: %w", wrapped))
+	if e, ok := ToEither[*codeError](r).GetLeft(); !ok || e != wrapped {
+		t.Errorf("expected errors.As to find the wrapped error, got %v, %v", e, ok)
+	}
+}
+
+func TestToEitherPanicsOnMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected ToEither to panic when the error cannot be converted")
+		}
+	}()
+	ToEither[*codeError](Err[int](errors.New("plain")))
+}
+
+func TestFromEither(t *testing.T) {
+	if r := FromEither(either.Right[error](3)); !r.IsOk() || r.Unwrap() != 3 {
+		t.Errorf("expected Ok(3), got %v", r)
+	}
+
+	err := errors.New("boom")
+	if r := FromEither(either.Left[error, int](err)); !errors.Is(r.Err(), err) {
+		t.Errorf("expected Err(boom), got %v", r)
+	}
+
+	if r := FromEither(either.Left[error, int](nil)); !r.IsErr() || !errors.Is(r.Err(), ErrNilLeft) {
+		t.Errorf("expected a nil Left to become Err(ErrNilLeft), got %v", r)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	if r := Guard(func() Result[int] { return Ok(1) }); r.Unwrap() != 1 {
+		t.Errorf("expected Ok(1), got %v", r)
+	}
+	err := errors.New("failed")
+	if r := Guard(func() Result[int] { return Err[int](err) }); !errors.Is(r.Err(), err) {
+		t.Errorf("expected the Err to be kept, got %v", r)
+	}
+
+	var panicErr *PanicError
+	r := Guard(func() Result[int] { panic("boom") })
+	if !errors.As(r.Err(), &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("expected a *PanicError holding boom, got %v", r)
+	}
+}
@@ -0,0 +1,69 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Backoff returns how long to wait before the given retry attempt.
+// attempt starts at 1 for the first retry.
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff waits the same delay before every retry.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff doubles the delay before every retry, starting at base and never exceeding max.
+func ExponentialBackoff(base, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		delay := base
+		for i := 1; i < attempt && delay < max; i++ {
+			delay *= 2
+		}
+		return min(delay, max)
+	}
+}
+
+// Retry calls fn up to attempts times, waiting according to backoff between calls,
+// until it returns Ok. The last Result is returned.
+// fn is always called at least once: an attempts value below 1 is treated as 1.
+func Retry[T any](attempts int, backoff Backoff, fn func() Result[T]) Result[T] {
+	return RetryCtx(context.Background(), attempts, backoff, nil, fn)
+}
+
+// RetryIf is like Retry, but stops as soon as fn returns an error for which retryable returns false.
+func RetryIf[T any](attempts int, backoff Backoff, retryable func(error) bool, fn func() Result[T]) Result[T] {
+	return RetryCtx(context.Background(), attempts, backoff, retryable, fn)
+}
+
+// RetryCtx is like RetryIf, but also stops waiting when ctx is done.
+// In that case the returned error joins ctx.Err() with the last error from fn.
+// A nil retryable retries every error. As with Retry, fn is always called at least once,
+// even if attempts is below 1 or ctx is already done.
+func RetryCtx[T any](ctx context.Context, attempts int, backoff Backoff, retryable func(error) bool, fn func() Result[T]) Result[T] {
+	r := fn()
+	for attempt := 1; attempt < attempts && r.err != nil; attempt++ {
+		if retryable != nil && !retryable(r.err) {
+			return r
+		}
+
+		if delay := backoff(attempt); delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return Err[T](errors.Join(ctx.Err(), r.err))
+			case <-timer.C:
+			}
+		} else if ctx.Err() != nil {
+			return Err[T](errors.Join(ctx.Err(), r.err))
+		}
+
+		r = fn()
+	}
+	return r
+}
@@ -0,0 +1,78 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// failing returns a function that fails the first n calls and then returns Ok(calls), counting every call.
+func failing(n int, err error, calls *int) func() Result[int] {
+	return func() Result[int] {
+		*calls++
+		if *calls <= n {
+			return Err[int](err)
+		}
+		return Ok(*calls)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	exp := ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond)
+	var delays []time.Duration
+	for attempt := 1; attempt <= 5; attempt++ {
+		delays = append(delays, exp(attempt))
+	}
+	if fmt.Sprint(delays) != "[10ms 20ms 40ms 50ms 50ms]" {
+		t.Errorf("expected doubling delays capped at 50ms, got %v", delays)
+	}
+	if d := ConstantBackoff(time.Second)(7); d != time.Second {
+		t.Errorf("expected 1s, got %v", d)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	err := errors.New("unavailable")
+	calls := 0
+	if r := Retry(5, ConstantBackoff(0), failing(2, err, &calls)); r.Unwrap() != 3 || calls != 3 {
+		t.Errorf("expected Ok(3) after 3 calls, got %v after %d calls", r, calls)
+	}
+
+	calls = 0
+	if r := Retry(3, ConstantBackoff(0), failing(10, err, &calls)); !errors.Is(r.Err(), err) || calls != 3 {
+		t.Errorf("expected the last error after 3 calls, got %v after %d calls", r, calls)
+	}
+
+	calls = 0
+	if r := Retry(0, ConstantBackoff(0), failing(10, err, &calls)); !r.IsErr() || calls != 1 {
+		t.Errorf("expected a single call for attempts 0, got %d calls", calls)
+	}
+}
+
+func TestRetryIf(t *testing.T) {
+	permanent := errors.New("not found")
+	calls := 0
+	r := RetryIf(5, ConstantBackoff(0), func(err error) bool { return !errors.Is(err, permanent) }, failing(10, permanent, &calls))
+	if !errors.Is(r.Err(), permanent) || calls != 1 {
+		t.Errorf("expected a non-retryable error to stop after 1 call, got %v after %d calls", r, calls)
+	}
+}
+
+func TestRetryCtx(t *testing.T) {
+	err := errors.New("unavailable")
+	for _, delay := range []time.Duration{0, time.Hour} {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		fn := func() Result[int] {
+			calls++
+			cancel()
+			return Err[int](err)
+		}
+		r := RetryCtx(ctx, 5, ConstantBackoff(delay), nil, fn)
+		if !errors.Is(r.Err(), context.Canceled) || !errors.Is(r.Err(), err) || calls != 1 {
+			t.Errorf("delay %v: expected the context and last errors after 1 call, got %v after %d calls", delay, r, calls)
+		}
+	}
+}
@@ -54,6 +54,56 @@
 //	    processData() // Can take up to ~1 hour if next task is 1 hour away
 //	})
 //
+// Use GoScoped instead of a bare go statement when the work should be cancelled
+// on shutdown; its context is cancelled when the scheduler stops:
+//
+//	s.Schedule(time.Second, func() {
+//	    s.GoScoped(func(ctx context.Context) {
+//	        processDataCtx(ctx)
+//	    })
+//	})
+//
+// LatenessStats shows whether this is happening: it reports how late recent
+// tasks started compared to their scheduled time:
+//
+//	if stats := s.LatenessStats(); stats.P95 > 50*time.Millisecond {
+//	    log.Printf("scheduler falling behind: p50=%v p95=%v max=%v", stats.P50, stats.P95, stats.Max)
+//	}
+//
+// # Priorities
+//
+// Tasks due at the same time run in scheduling order. ScheduleWithPriority lets
+// critical timers go first; priority only breaks ties and never reorders tasks
+// due at different times:
+//
+//	s.ScheduleWithPriority(time.Minute, 10, healthCheck) // ahead of priority 0 tasks due at the same instant
+//
+// # Periodic Ticks
+//
+// Services with many periodic loops can share the scheduler goroutine instead of
+// creating one time.Ticker per loop:
+//
+//	for t := range s.TicksChan(ctx, 30*time.Second) {
+//	    refresh(t)
+//	}
+//
+//	// Or as a stream
+//	s.Ticks(time.Second).Limit(10).ForEach(func(t time.Time) {
+//	    fmt.Println("tick", t)
+//	})
+//
+// # Dry Runs
+//
+// Upcoming and Simulate project when tasks will run without executing anything,
+// including the repeats of recurring tasks such as tickers:
+//
+//	for _, run := range s.Upcoming(10) {
+//	    fmt.Println(run.ID, run.RunAt, run.Interval)
+//	}
+//
+//	// Everything due during tonight's maintenance window
+//	runs := s.Simulate(windowStart, windowEnd)
+//
 // # Thread Safety
 //
 // The scheduler is safe for concurrent use. Multiple goroutines can schedule
@@ -5,7 +5,8 @@ import (
 )
 
 // taskHeap implements heap.Interface for tasks ordered by execution time.
-// The task with the earliest runAt time is at the root (index 0).
+// The task with the earliest runAt time is at the root (index 0); ties are broken by
+// priority, then by scheduling order.
 type taskHeap []*Task
 
 // Len returns the number of tasks in the heap.
@@ -14,9 +15,16 @@ func (h taskHeap) Len() int {
 }
 
 // Less reports whether the task at index i should execute before the task at index j.
-// Tasks are ordered by their runAt time (earliest first).
+// Tasks are ordered by their runAt time (earliest first). Tasks due at the same time are
+// ordered by priority (highest first), then by ID so that equal priorities run in scheduling order.
 func (h taskHeap) Less(i, j int) bool {
-	return h[i].runAt.Before(h[j].runAt)
+	if !h[i].runAt.Equal(h[j].runAt) {
+		return h[i].runAt.Before(h[j].runAt)
+	}
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].id < h[j].id
 }
 
 // Swap exchanges the tasks at indices i and j.
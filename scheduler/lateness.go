@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// latenessWindow is the number of most recent executions LatenessStats reports on.
+const latenessWindow = 1024
+
+// LatenessStats summarizes how late recent tasks started, measured as the actual start time
+// minus the scheduled time. Lateness that grows over time means task execution is delaying
+// the queue; see the package documentation.
+type LatenessStats struct {
+	// Samples is the number of executions the statistics cover, at most the last 1024.
+	Samples int
+	// P50 is the median lateness.
+	P50 time.Duration
+	// P95 is the lateness that 95% of executions stayed within.
+	P95 time.Duration
+	// Max is the largest lateness.
+	Max time.Duration
+}
+
+// latenessRing keeps the lateness of the most recent executions in a ring buffer.
+type latenessRing struct {
+	mu      sync.Mutex
+	samples [latenessWindow]time.Duration
+	next    int
+	count   int
+}
+
+func (r *latenessRing) record(d time.Duration) {
+	r.mu.Lock()
+	r.samples[r.next] = d
+	r.next = (r.next + 1) % latenessWindow
+	r.count = min(r.count+1, latenessWindow)
+	r.mu.Unlock()
+}
+
+func (r *latenessRing) stats() LatenessStats {
+	r.mu.Lock()
+	sorted := slices.Clone(r.samples[:r.count])
+	r.mu.Unlock()
+
+	if len(sorted) == 0 {
+		return LatenessStats{}
+	}
+	slices.Sort(sorted)
+	return LatenessStats{
+		Samples: len(sorted),
+		P50:     percentile(sorted, 0.50),
+		P95:     percentile(sorted, 0.95),
+		Max:     sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile p of sorted, which must not be empty.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
+
+// LatenessStats reports how late the last 1024 executed tasks started compared to their
+// scheduled time. It returns zero statistics if no task has run yet.
+//
+// A rising P95 or Max is an early sign that tasks run longer than the gap to the next task,
+// or that many tasks are due at once; move slow work into goroutines, for example with GoScoped.
+func (s *Scheduler) LatenessStats() LatenessStats {
+	return s.lateness.stats()
+}
@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"container/heap"
+	"context"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -16,6 +17,10 @@ type Scheduler struct {
 	stopCh  chan struct{}
 	running atomic.Bool
 	nextID  atomic.Uint64
+	ctx     context.Context
+	cancel  context.CancelFunc
+	// lateness records how late recent executions started, for LatenessStats.
+	lateness latenessRing
 }
 
 // New creates a new Scheduler.
@@ -26,6 +31,7 @@ func New() *Scheduler {
 		wakeup: make(chan struct{}, 1),
 		stopCh: make(chan struct{}),
 	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
 	heap.Init(&s.tasks)
 	return s
 }
@@ -48,6 +54,14 @@ func (s *Scheduler) Stop() {
 		return
 	}
 	close(s.stopCh)
+	s.cancel()
+}
+
+// GoScoped runs fn in a new goroutine with a context that is cancelled when the scheduler stops.
+// Use it from tasks to start long-running work that must be cleaned up on shutdown.
+// Stop does not wait for these goroutines to return.
+func (s *Scheduler) GoScoped(fn func(ctx context.Context)) {
+	go fn(s.ctx)
 }
 
 // Schedule schedules a function to execute after the specified delay.
@@ -74,9 +88,29 @@ func (s *Scheduler) ScheduleAt(at time.Time, fn func()) TaskID {
 	if at.Before(time.Now()) {
 		panic("scheduler: cannot schedule task in the past")
 	}
+	return s.scheduleAt(at, 0, 0, fn)
+}
+
+// ScheduleWithPriority schedules a function to execute after the specified delay, like Schedule.
+// Among tasks due at the same time, those with a higher priority execute first, so critical
+// timers such as health checks can run ahead of bulk maintenance queued for the same instant.
+// Tasks scheduled with Schedule or ScheduleAt have priority 0; negative priorities run after them.
+// Tasks with equal time and priority execute in the order they were scheduled.
+//
+// Priority only breaks ties: a task due earlier always runs before one due later.
+func (s *Scheduler) ScheduleWithPriority(delay time.Duration, priority int, fn func()) TaskID {
+	return s.scheduleAt(time.Now().Add(delay), 0, priority, fn)
+}
 
+// scheduleAt schedules fn at the given time. A positive interval marks the task as recurring:
+// it reschedules itself every interval, which lets Upcoming and Simulate project its repeats.
+// Unlike ScheduleAt, it accepts a time that has already passed and runs the task as soon as possible,
+// since internal callers compute at slightly before scheduling it.
+func (s *Scheduler) scheduleAt(at time.Time, interval time.Duration, priority int, fn func()) TaskID {
 	id := TaskID(s.nextID.Add(1))
 	task := newTask(id, at, fn)
+	task.interval = interval
+	task.priority = priority
 
 	s.mu.Lock()
 	wasEmpty := s.tasks.Len() == 0
@@ -172,6 +206,7 @@ func (s *Scheduler) run() {
 
 			if task != nil && !task.IsCancelled() {
 				start := time.Now()
+				s.lateness.record(start.Sub(task.RunAt()))
 				task.Execute()
 				executionTime := time.Since(start)
 
@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -62,6 +63,52 @@ func TestSchedulerOrder(t *testing.T) {
 	}
 }
 
+func TestSchedulerPriority(t *testing.T) {
+	s := New()
+
+	var mu sync.Mutex
+	order := []int{}
+	record := func(n int) func() {
+		return func() {
+			mu.Lock()
+			order = append(order, n)
+			mu.Unlock()
+		}
+	}
+
+	// Tasks due at the same instant run by priority, then in scheduling order.
+	at := time.Now().Add(30 * time.Millisecond)
+	s.scheduleAt(at, 0, 0, record(3))
+	s.scheduleAt(at, 0, -1, record(5))
+	s.scheduleAt(at, 0, 10, record(1))
+	s.scheduleAt(at, 0, 0, record(4))
+	s.scheduleAt(at, 0, 5, record(2))
+	// Priority never lets a later task run before an earlier one.
+	s.ScheduleWithPriority(60*time.Millisecond, 100, record(6))
+
+	upcoming := s.Upcoming(6)
+	if upcoming[0].Priority != 10 || upcoming[5].Priority != 100 {
+		t.Errorf("expected Upcoming to follow priorities, got %+v", upcoming)
+	}
+
+	s.Start()
+	defer s.Stop()
+	time.Sleep(120 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []int{1, 2, 3, 4, 5, 6}
+	if len(order) != len(expected) {
+		t.Fatalf("expected %d tasks, got %d", len(expected), len(order))
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Errorf("tasks executed in wrong order: %v", order)
+			break
+		}
+	}
+}
+
 func TestSchedulerCancel(t *testing.T) {
 	s := New()
 	s.Start()
@@ -201,6 +248,191 @@ func TestSchedulerClear(t *testing.T) {
 	}
 }
 
+func TestSchedulerGoScoped(t *testing.T) {
+	s := New()
+	s.Start()
+
+	started := make(chan struct{})
+	done := make(chan struct{})
+	s.GoScoped(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		close(done)
+	})
+
+	<-started
+	s.Stop()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scoped goroutine context not cancelled on Stop")
+	}
+}
+
+func TestSchedulerTicksChan(t *testing.T) {
+	s := New()
+	s.Start()
+	defer s.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks := s.TicksChan(ctx, 20*time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		select {
+		case <-ticks:
+		case <-time.After(time.Second):
+			t.Fatalf("tick %d not received", i)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 55*time.Millisecond {
+		t.Errorf("expected ticks every ~20ms, got 3 ticks in %v", elapsed)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-ticks:
+		for ok {
+			_, ok = <-ticks
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel not closed after context cancellation")
+	}
+}
+
+func TestSchedulerTickLate(t *testing.T) {
+	s := New()
+	tk := &ticker{
+		s:        s,
+		interval: time.Nanosecond,
+		ch:       make(chan time.Time, 1),
+		next:     time.Now().Add(-time.Second),
+	}
+
+	// The next tick is computed just after now, and is already past when it is scheduled.
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("late tick panicked: %v", r)
+		}
+	}()
+	tk.tick()
+
+	select {
+	case <-tk.ch:
+	default:
+		t.Error("expected the late tick to be delivered")
+	}
+	if s.Pending() != 1 {
+		t.Errorf("expected the next tick to be scheduled, got %d tasks", s.Pending())
+	}
+}
+
+func TestSchedulerTicksStream(t *testing.T) {
+	s := New()
+	s.Start()
+	defer s.Stop()
+
+	ticks := s.Ticks(10 * time.Millisecond).Limit(3).ToSlice()
+	if len(ticks) != 3 {
+		t.Fatalf("expected 3 ticks, got %d", len(ticks))
+	}
+	for i := 1; i < len(ticks); i++ {
+		if !ticks[i].After(ticks[i-1]) {
+			t.Errorf("ticks not increasing: %v", ticks)
+		}
+	}
+}
+
+func TestSchedulerUpcoming(t *testing.T) {
+	s := New()
+	now := time.Now()
+	late := s.ScheduleAt(now.Add(time.Hour), func() {})
+	cancelled := s.ScheduleAt(now.Add(time.Minute), func() {})
+	s.Cancel(cancelled)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.TicksChan(ctx, 25*time.Minute)
+
+	upcoming := s.Upcoming(3)
+	if len(upcoming) != 3 {
+		t.Fatalf("expected 3 executions, got %d", len(upcoming))
+	}
+	if upcoming[0].Interval != 25*time.Minute || upcoming[1].Interval != 25*time.Minute {
+		t.Errorf("expected two ticks first, got %+v", upcoming)
+	}
+	if upcoming[2].ID != late || upcoming[2].Interval != 0 {
+		t.Errorf("expected the one-shot task third, got %+v", upcoming[2])
+	}
+	if s.Pending() != 3 {
+		t.Errorf("expected Upcoming not to change the queue, got %d pending", s.Pending())
+	}
+}
+
+func TestSchedulerSimulate(t *testing.T) {
+	s := New()
+	now := time.Now()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.TicksChan(ctx, time.Minute)
+	s.ScheduleAt(now.Add(10*time.Hour), func() {})
+
+	from, to := now.Add(30*time.Minute+30*time.Second), now.Add(90*time.Minute+30*time.Second)
+	runs := s.Simulate(from, to)
+	if len(runs) != 60 {
+		t.Errorf("expected 60 ticks in one hour, got %d", len(runs))
+	}
+	for _, run := range runs {
+		if run.RunAt.Before(from) || run.RunAt.After(to) {
+			t.Errorf("execution at %v is outside the simulated range", run.RunAt)
+		}
+	}
+}
+
+func TestSchedulerLatenessStats(t *testing.T) {
+	s := New()
+	if stats := s.LatenessStats(); stats.Samples != 0 {
+		t.Errorf("expected no samples before any task ran, got %+v", stats)
+	}
+
+	s.Start()
+	defer s.Stop()
+
+	done := make(chan struct{})
+	// The first task blocks the queue, so the tasks due during its run start late.
+	s.Schedule(10*time.Millisecond, func() { time.Sleep(50 * time.Millisecond) })
+	for i := 1; i <= 3; i++ {
+		s.Schedule(time.Duration(10+i)*time.Millisecond, func() {})
+	}
+	s.Schedule(100*time.Millisecond, func() { close(done) })
+	<-done
+
+	stats := s.LatenessStats()
+	if stats.Samples != 5 {
+		t.Fatalf("expected 5 samples, got %d", stats.Samples)
+	}
+	if stats.Max < 40*time.Millisecond || stats.P95 != stats.Max {
+		t.Errorf("expected the blocked tasks to start ~50ms late, got %+v", stats)
+	}
+	if stats.P50 > stats.P95 {
+		t.Errorf("expected P50 <= P95, got %+v", stats)
+	}
+}
+
+func TestLatenessRingWindow(t *testing.T) {
+	var r latenessRing
+	for i := 1; i <= latenessWindow+100; i++ {
+		r.record(time.Duration(i))
+	}
+	stats := r.stats()
+	if stats.Samples != latenessWindow || stats.Max != latenessWindow+100 {
+		t.Errorf("expected the last %d samples, got %+v", latenessWindow, stats)
+	}
+	if stats.P50 != 100+latenessWindow/2 {
+		t.Errorf("expected median %d, got %d", 100+latenessWindow/2, stats.P50)
+	}
+}
+
 func BenchmarkSchedule(b *testing.B) {
 	s := New()
 	s.Start()
@@ -0,0 +1,106 @@
+package scheduler
+
+import (
+	"container/heap"
+	"time"
+)
+
+// TaskInfo describes a projected execution of a scheduled task.
+type TaskInfo struct {
+	// ID identifies the pending task. Projected repeats of a recurring task share the ID
+	// of its pending occurrence, although each actual repeat is scheduled under a new ID.
+	ID TaskID
+	// RunAt is the time the execution is projected for.
+	RunAt time.Time
+	// Interval is the period of a recurring task, such as a TicksChan ticker, or 0 for a one-shot task.
+	Interval time.Duration
+	// Priority orders executions projected for the same time; higher runs first.
+	Priority int
+}
+
+// Upcoming returns the next n projected executions in time order, without executing anything.
+// Recurring tasks contribute one entry per projected repeat; cancelled tasks are left out.
+// Projections assume tasks run on time and are not cancelled or rescheduled in the meantime.
+func (s *Scheduler) Upcoming(n int) []TaskInfo {
+	result := make([]TaskInfo, 0, max(n, 0))
+	s.project(func(info TaskInfo) bool {
+		if len(result) >= n {
+			return false
+		}
+		result = append(result, info)
+		return true
+	})
+	return result
+}
+
+// Simulate returns every projected execution between from and to, both inclusive, in time order,
+// without executing anything. It is a dry run for checking complex schedules; see Upcoming for
+// the assumptions projections make.
+func (s *Scheduler) Simulate(from, to time.Time) []TaskInfo {
+	var result []TaskInfo
+	s.project(func(info TaskInfo) bool {
+		if info.RunAt.After(to) {
+			return false
+		}
+		if !info.RunAt.Before(from) {
+			result = append(result, info)
+		}
+		return true
+	})
+	return result
+}
+
+// project calls fn for each projected execution in time order until fn returns false
+// or no execution is left. The task queue is snapshotted first and is not locked while fn runs.
+func (s *Scheduler) project(fn func(TaskInfo) bool) {
+	s.mu.Lock()
+	pending := make(infoHeap, 0, s.tasks.Len())
+	for _, task := range s.tasks {
+		if !task.IsCancelled() {
+			pending = append(pending, TaskInfo{ID: task.id, RunAt: task.runAt, Interval: task.interval, Priority: task.priority})
+		}
+	}
+	s.mu.Unlock()
+
+	heap.Init(&pending)
+	for pending.Len() > 0 {
+		next := pending[0]
+		if !fn(next) {
+			return
+		}
+		if next.Interval > 0 {
+			pending[0].RunAt = next.RunAt.Add(next.Interval)
+			heap.Fix(&pending, 0)
+		} else {
+			heap.Pop(&pending)
+		}
+	}
+}
+
+// infoHeap implements heap.Interface for projected executions, earliest first.
+// Executions at the same time are ordered like the task queue: by priority, then by task ID.
+type infoHeap []TaskInfo
+
+func (h infoHeap) Len() int { return len(h) }
+
+func (h infoHeap) Less(i, j int) bool {
+	if h[i].RunAt.Equal(h[j].RunAt) {
+		if h[i].Priority != h[j].Priority {
+			return h[i].Priority > h[j].Priority
+		}
+		return h[i].ID < h[j].ID
+	}
+	return h[i].RunAt.Before(h[j].RunAt)
+}
+
+func (h infoHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *infoHeap) Push(x any) { *h = append(*h, x.(TaskInfo)) }
+
+func (h *infoHeap) Pop() any {
+	old := *h
+	n := len(old)
+	info := old[n-1]
+	*h = old[:n-1]
+	return info
+}
@@ -15,6 +15,10 @@ type Task struct {
 	runAt     time.Time
 	fn        func()
 	cancelled atomic.Bool
+	// interval is the period of a recurring task, 0 for a one-shot task.
+	interval time.Duration
+	// priority orders tasks due at the same time; higher runs first.
+	priority int
 }
 
 // newTask creates a new task with the given ID, execution time, and function.
@@ -36,6 +40,12 @@ func (t *Task) RunAt() time.Time {
 	return t.runAt
 }
 
+// Priority returns the task's priority. Among tasks due at the same time,
+// those with a higher priority execute first.
+func (t *Task) Priority() int {
+	return t.priority
+}
+
 // Cancel marks the task as cancelled.
 // The task will be skipped when its execution time arrives.
 func (t *Task) Cancel() {
@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/marouanesouiri/stdx/stream"
+)
+
+// ticker delivers periodic ticks by rescheduling itself on the scheduler.
+type ticker struct {
+	s        *Scheduler
+	interval time.Duration
+	ch       chan time.Time
+
+	mu     sync.Mutex
+	next   time.Time
+	taskID TaskID
+	closed bool
+}
+
+// TicksChan returns a channel that receives the current time every interval,
+// like time.Ticker, but driven by the scheduler's single goroutine instead of a
+// dedicated timer per caller.
+//
+// As with time.Ticker, ticks are dropped if the receiver falls behind, and missed
+// intervals are skipped rather than delivered in a burst.
+// The channel is closed once ctx is done. Stopping the scheduler stops the ticks
+// but does not close the channel.
+//
+// Panics if interval is not positive.
+func (s *Scheduler) TicksChan(ctx context.Context, interval time.Duration) <-chan time.Time {
+	if interval <= 0 {
+		panic("scheduler: non-positive interval for TicksChan")
+	}
+
+	t := &ticker{
+		s:        s,
+		interval: interval,
+		ch:       make(chan time.Time, 1),
+	}
+
+	t.mu.Lock()
+	t.next = time.Now().Add(interval)
+	t.taskID = s.scheduleAt(t.next, interval, 0, t.tick)
+	t.mu.Unlock()
+
+	context.AfterFunc(ctx, t.stop)
+	return t.ch
+}
+
+// Ticks returns an infinite Stream of tick times, one every interval.
+// The ticks are driven by the scheduler; see TicksChan for delivery semantics.
+// The underlying ticker is released as soon as the stream consumer stops,
+// for example after Limit or TakeWhile.
+func (s *Scheduler) Ticks(interval time.Duration) stream.Stream[time.Time] {
+	return stream.FromSeq(func(yield func(time.Time) bool) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		for tick := range s.TicksChan(ctx, interval) {
+			if !yield(tick) {
+				return
+			}
+		}
+	})
+}
+
+// tick delivers one tick and schedules the next one.
+func (t *ticker) tick() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return
+	}
+
+	now := time.Now()
+	select {
+	case t.ch <- now:
+	default:
+	}
+
+	t.next = t.next.Add(t.interval)
+	if !t.next.After(now) {
+		missed := now.Sub(t.next)/t.interval + 1
+		t.next = t.next.Add(missed * t.interval)
+	}
+	t.taskID = t.s.scheduleAt(t.next, t.interval, 0, t.tick)
+}
+
+// stop cancels the pending tick and closes the channel.
+func (t *ticker) stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return
+	}
+	t.closed = true
+	t.s.Cancel(t.taskID)
+	close(t.ch)
+}
@@ -0,0 +1,141 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+)
+
+// CheckInvariants verifies the internal consistency of s and returns an error describing the
+// first violation, or nil. It checks that s was created by a constructor rather than being a
+// zero Set, that Size matches the number of elements iterated, and that every iterated element
+// is found by Contains, which fails for elements that are not equal to themselves, such as NaN.
+// It is meant for tests, typically property-based ones built on Arbitrary.
+func CheckInvariants[T comparable](s Set[T]) error {
+	if s.items == nil {
+		return errors.New("set: zero Set has no backing map; create sets with New")
+	}
+	count := 0
+	var err error
+	s.Range(func(item T) bool {
+		count++
+		if !s.Contains(item) {
+			err = fmt.Errorf("set: element %v is not found by Contains", item)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if count != s.Size() {
+		return fmt.Errorf("set: Size is %d but %d elements were iterated", s.Size(), count)
+	}
+	return nil
+}
+
+// CheckLaws verifies that the set operations obey the laws of set algebra for a, b and c, and
+// returns an error naming the first law that does not hold, or nil. It checks commutativity and
+// associativity of Union and Intersection, distributivity, De Morgan's laws relative to a, the
+// definition of SymmetricDifference, and the consistency of IsSubset, IsSuperset and Equal.
+// None of the sets is modified.
+func CheckLaws[T comparable](a, b, c Set[T]) error {
+	union := func(x, y Set[T]) Set[T] { return x.Union(y) }
+	inter := func(x, y Set[T]) Set[T] { return x.Intersection(y) }
+	diff := func(x, y Set[T]) Set[T] { return x.Difference(y) }
+
+	laws := []struct {
+		name        string
+		left, right Set[T]
+	}{
+		{"a ∪ b = b ∪ a", union(a, b), union(b, a)},
+		{"a ∩ b = b ∩ a", inter(a, b), inter(b, a)},
+		{"(a ∪ b) ∪ c = a ∪ (b ∪ c)", union(union(a, b), c), union(a, union(b, c))},
+		{"(a ∩ b) ∩ c = a ∩ (b ∩ c)", inter(inter(a, b), c), inter(a, inter(b, c))},
+		{"a ∩ (b ∪ c) = (a ∩ b) ∪ (a ∩ c)", inter(a, union(b, c)), union(inter(a, b), inter(a, c))},
+		{"a ∪ (b ∩ c) = (a ∪ b) ∩ (a ∪ c)", union(a, inter(b, c)), inter(union(a, b), union(a, c))},
+		{"a \\ (b ∪ c) = (a \\ b) ∩ (a \\ c)", diff(a, union(b, c)), inter(diff(a, b), diff(a, c))},
+		{"a \\ (b ∩ c) = (a \\ b) ∪ (a \\ c)", diff(a, inter(b, c)), union(diff(a, b), diff(a, c))},
+		{"a △ b = (a \\ b) ∪ (b \\ a)", a.SymmetricDifference(b), union(diff(a, b), diff(b, a))},
+		{"a ∪ a = a", union(a, a), a},
+		{"a ∩ a = a", inter(a, a), a},
+	}
+	for _, law := range laws {
+		if err := CheckInvariants(law.left); err != nil {
+			return fmt.Errorf("%w (in %s)", err, law.name)
+		}
+		if !law.left.Equal(law.right) {
+			return fmt.Errorf("set: law %s does not hold: %s != %s", law.name, law.left.String(), law.right.String())
+		}
+	}
+
+	ab, aUnionB := inter(a, b), union(a, b)
+	switch {
+	case !ab.IsSubset(a) || !a.IsSuperset(ab):
+		return errors.New("set: a ∩ b is not a subset of a")
+	case (a.IsSubset(b) && b.IsSubset(a)) != a.Equal(b):
+		return errors.New("set: a ⊆ b and b ⊆ a disagree with a = b")
+	case a.IsSubset(b) != aUnionB.Equal(b):
+		return errors.New("set: a ⊆ b disagrees with a ∪ b = b")
+	}
+	return nil
+}
+
+// Arbitrary returns a random Set of up to n elements for property-based tests.
+// Elements are drawn from a small domain, about 2n values per number or string, so that
+// independently generated sets overlap, which keeps intersection and difference laws meaningful.
+// The set has fewer than n elements if T has fewer distinct values, as bool does.
+//
+// T must be built from booleans, numbers, strings, arrays and structs with exported fields;
+// Arbitrary panics for other element types.
+func Arbitrary[T comparable](rng *rand.Rand, n int) Set[T] {
+	s := withCapacity[T](n)
+	t := reflect.TypeFor[T]()
+	domain := max(2*n, 1)
+	for attempts := 0; s.Size() < n && attempts < 10*n; attempts++ {
+		v := reflect.New(t).Elem()
+		arbitraryValue(rng, v, domain)
+		s.Add(v.Interface().(T))
+	}
+	return s
+}
+
+// Generate returns a random Set of up to size elements, like Arbitrary.
+// It implements the Generator interface of testing/quick, so Set values can be
+// parameters of quick.Check properties.
+func (Set[T]) Generate(rng *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Arbitrary[T](rng, rng.Intn(size+1)))
+}
+
+// arbitraryValue fills v with a random value whose numbers and strings come from a domain of the given size.
+func arbitraryValue(rng *rand.Rand, v reflect.Value, domain int) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(rng.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(rng.Intn(domain) - domain/2))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(uint64(rng.Intn(domain)))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(rng.Intn(domain)-domain/2) / 2)
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(float64(rng.Intn(domain)), float64(rng.Intn(domain))))
+	case reflect.String:
+		v.SetString(strconv.FormatInt(int64(rng.Intn(domain)), 36))
+	case reflect.Array:
+		for i := range v.Len() {
+			arbitraryValue(rng, v.Index(i), domain)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if !v.Type().Field(i).IsExported() {
+				panic(fmt.Sprintf("set: Arbitrary cannot generate unexported field %s of %s", v.Type().Field(i).Name, v.Type()))
+			}
+			arbitraryValue(rng, v.Field(i), domain)
+		}
+	default:
+		panic(fmt.Sprintf("set: Arbitrary cannot generate elements of type %s", v.Type()))
+	}
+}
@@ -0,0 +1,59 @@
+package set
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	if err := CheckInvariants(FromSlice([]int{1, 2, 3})); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := CheckInvariants(Set[int]{}); err == nil {
+		t.Error("expected an error for a zero Set")
+	}
+	if err := CheckInvariants(FromSlice([]float64{1, math.NaN()})); err == nil {
+		t.Error("expected an error for a NaN element")
+	}
+}
+
+func TestCheckLaws(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 200 {
+		a, b, c := Arbitrary[int](rng, 10), Arbitrary[int](rng, 10), Arbitrary[int](rng, 10)
+		if err := CheckLaws(a, b, c); err != nil {
+			t.Fatalf("expected laws to hold for %s, %s, %s, got %v", a.String(), b.String(), c.String(), err)
+		}
+	}
+
+	type point struct{ X, Y int8 }
+	prop := func(a, b, c Set[point]) bool {
+		return CheckLaws(a, b, c) == nil
+	}
+	if err := quick.Check(prop, &quick.Config{Rand: rand.New(rand.NewSource(1))}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestArbitrary(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	a, b := Arbitrary[string](rng, 20), Arbitrary[string](rng, 20)
+	if a.Size() != 20 || b.Size() != 20 {
+		t.Errorf("expected 20 elements, got %d and %d", a.Size(), b.Size())
+	}
+	if common := a.Intersection(b); common.IsEmpty() {
+		t.Error("expected independently generated sets to overlap")
+	}
+	if bools := Arbitrary[bool](rng, 5); bools.Size() != 2 {
+		t.Errorf("expected both booleans, got %s", bools.String())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for an unsupported element type")
+		}
+	}()
+	Arbitrary[*int](rng, 1)
+}
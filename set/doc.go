@@ -107,6 +107,19 @@
 //
 //	slice := s.ToSlice() // Convert to slice
 //
+// # Aggregations
+//
+// Numeric and ordered sets can be aggregated directly:
+//
+//	s := set.FromSlice([]int{3, 1, 4})
+//
+//	set.Sum(s)     // 8
+//	set.Product(s) // 12
+//	set.MinOf(s)   // Some(1)
+//	set.MaxOf(s)   // Some(4)
+//
+// MinOf and MaxOf return None for an empty set.
+//
 // # Copying Sets
 //
 //	original := set.FromSlice([]int{1, 2, 3})
@@ -206,6 +219,39 @@
 //   - Convenience methods
 //   - Clear intent in code
 //
+// # Encoding
+//
+// Encode and Decode store a set in a compact, versioned binary format. Elements are written
+// in sorted order, so equal sets always produce the same bytes, which makes the output
+// suitable for files, key-value stores, checksums and diffs:
+//
+//	var buf bytes.Buffer
+//	if err := s.Encode(&buf); err != nil {
+//	    return err
+//	}
+//
+//	restored := set.New[string]()
+//	if err := restored.Decode(&buf); err != nil {
+//	    return err // set.ErrEncoding for corrupt data or a different element type
+//	}
+//
+// # Property-Based Testing
+//
+// Arbitrary generates random sets whose elements overlap between calls, CheckLaws verifies the
+// laws of set algebra (commutativity, distributivity, De Morgan and more) and CheckInvariants
+// verifies a single set, so code building on Set can test its properties without its own generators:
+//
+//	rng := rand.New(rand.NewSource(seed))
+//	for range 1000 {
+//	    a, b, c := set.Arbitrary[int](rng, 20), set.Arbitrary[int](rng, 20), set.Arbitrary[int](rng, 20)
+//	    if err := set.CheckLaws(a, b, c); err != nil {
+//	        t.Fatal(err)
+//	    }
+//	}
+//
+// Set also implements the Generator interface of testing/quick, so sets can be used
+// directly as arguments of properties passed to quick.Check.
+//
 // # Thread Safety
 //
 // Set is not thread-safe. For concurrent access, use external synchronization:
@@ -0,0 +1,268 @@
+package set
+
+import (
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+)
+
+// ErrEncoding is returned by Decode when the data was not produced by Encode
+// for a set of the same element type.
+var ErrEncoding = errors.New("set: invalid encoding")
+
+const encodingVersion = 1
+
+var encodingMagic = [4]byte{'S', 'S', 'E', 'T'}
+
+// codec identifies how elements are encoded. It is stored in the header so that
+// decoding into a set of an incompatible element type fails instead of producing garbage.
+type codec byte
+
+const (
+	codecString codec = iota + 1
+	codecInt
+	codecUint
+	codecFloat
+	codecBool
+	codecBinary
+	codecGob
+)
+
+var binaryMarshalerType = reflect.TypeFor[encoding.BinaryMarshaler]()
+
+// codecFor returns the codec used for elements of type t.
+func codecFor(t reflect.Type) codec {
+	if t.Implements(binaryMarshalerType) && reflect.PointerTo(t).Implements(reflect.TypeFor[encoding.BinaryUnmarshaler]()) {
+		return codecBinary
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return codecString
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return codecInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return codecUint
+	case reflect.Float32, reflect.Float64:
+		return codecFloat
+	case reflect.Bool:
+		return codecBool
+	default:
+		return codecGob
+	}
+}
+
+// Encode writes the set to w in a deterministic, versioned binary format:
+// a header holding a magic number, the format version and the element codec,
+// then the element count and every element, each prefixed with its length.
+//
+// Elements are written in ascending order for strings and numbers, and in the order of their
+// encoded bytes otherwise, so equal sets always produce identical bytes and can be diffed or
+// hashed across runs. Strings, numbers and booleans have a compact built-in encoding; types
+// implementing encoding.BinaryMarshaler use it, and anything else is encoded with encoding/gob.
+func (s *Set[T]) Encode(w io.Writer) error {
+	c := codecFor(reflect.TypeFor[T]())
+
+	items := make([]T, 0, len(s.items))
+	for item := range s.items {
+		items = append(items, item)
+	}
+	encoded := make([][]byte, len(items))
+	for i, item := range items {
+		b, err := encodeElement(c, item)
+		if err != nil {
+			return fmt.Errorf("set: encoding element: %w", err)
+		}
+		encoded[i] = b
+	}
+
+	switch c {
+	case codecString, codecInt, codecUint, codecFloat:
+		order := make([]int, len(items))
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortFunc(order, func(a, b int) int {
+			return compareOrdered(c, reflect.ValueOf(items[a]), reflect.ValueOf(items[b]))
+		})
+		sorted := make([][]byte, len(order))
+		for i, idx := range order {
+			sorted[i] = encoded[idx]
+		}
+		encoded = sorted
+	default:
+		slices.SortFunc(encoded, bytes.Compare)
+	}
+
+	buf := append(encodingMagic[:], encodingVersion, byte(c))
+	buf = binary.AppendUvarint(buf, uint64(len(encoded)))
+	for _, b := range encoded {
+		buf = binary.AppendUvarint(buf, uint64(len(b)))
+		buf = append(buf, b...)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// Decode replaces the contents of the set with a set read from r, as written by Encode.
+// The set is left unchanged if decoding fails.
+// It reads exactly the bytes Encode wrote, so several sets can be stored back to back.
+// It returns ErrEncoding if the data is malformed, from another format version, or
+// was encoded for an incompatible element type.
+func (s *Set[T]) Decode(r io.Reader) error {
+	br := byteReader{r: r}
+
+	var header [6]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return decodeErr(err)
+	}
+	if [4]byte(header[:4]) != encodingMagic || header[4] != encodingVersion {
+		return ErrEncoding
+	}
+	c := codecFor(reflect.TypeFor[T]())
+	if codec(header[5]) != c {
+		return ErrEncoding
+	}
+
+	count, err := binary.ReadUvarint(&br)
+	if err != nil {
+		return decodeErr(err)
+	}
+	items := make(map[T]struct{}, min(count, 1<<16))
+	var b []byte
+	for range count {
+		n, err := binary.ReadUvarint(&br)
+		if err != nil {
+			return decodeErr(err)
+		}
+		if n > math.MaxInt32 {
+			return ErrEncoding
+		}
+		b = slices.Grow(b[:0], int(n))[:n]
+		if _, err := io.ReadFull(r, b); err != nil {
+			return decodeErr(err)
+		}
+		item, err := decodeElement[T](c, b)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrEncoding, err)
+		}
+		items[item] = struct{}{}
+	}
+	if s.items == nil {
+		s.items = items
+		return nil
+	}
+	clear(s.items)
+	for item := range items {
+		s.items[item] = struct{}{}
+	}
+	return nil
+}
+
+// decodeErr reports a truncated input as ErrEncoding and passes other read errors through.
+func decodeErr(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrEncoding
+	}
+	return err
+}
+
+// byteReader reads single bytes from r without buffering, so Decode never consumes
+// more input than the encoded set.
+type byteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+func (b *byteReader) ReadByte() (byte, error) {
+	_, err := io.ReadFull(b.r, b.buf[:])
+	return b.buf[0], err
+}
+
+func encodeElement[T any](c codec, item T) ([]byte, error) {
+	v := reflect.ValueOf(&item).Elem()
+	switch c {
+	case codecString:
+		return []byte(v.String()), nil
+	case codecInt:
+		return binary.AppendVarint(nil, v.Int()), nil
+	case codecUint:
+		return binary.AppendUvarint(nil, v.Uint()), nil
+	case codecFloat:
+		return binary.BigEndian.AppendUint64(nil, math.Float64bits(v.Float())), nil
+	case codecBool:
+		if v.Bool() {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case codecBinary:
+		return v.Interface().(encoding.BinaryMarshaler).MarshalBinary()
+	default:
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).EncodeValue(v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+}
+
+func decodeElement[T any](c codec, b []byte) (T, error) {
+	var item T
+	v := reflect.ValueOf(&item).Elem()
+	switch c {
+	case codecString:
+		v.SetString(string(b))
+	case codecInt:
+		x, n := binary.Varint(b)
+		if n != len(b) || v.OverflowInt(x) {
+			return item, errors.New("invalid integer")
+		}
+		v.SetInt(x)
+	case codecUint:
+		x, n := binary.Uvarint(b)
+		if n != len(b) || v.OverflowUint(x) {
+			return item, errors.New("invalid unsigned integer")
+		}
+		v.SetUint(x)
+	case codecFloat:
+		if len(b) != 8 {
+			return item, errors.New("invalid float")
+		}
+		v.SetFloat(math.Float64frombits(binary.BigEndian.Uint64(b)))
+	case codecBool:
+		if len(b) != 1 || b[0] > 1 {
+			return item, errors.New("invalid bool")
+		}
+		v.SetBool(b[0] == 1)
+	case codecBinary:
+		if err := v.Addr().Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(b); err != nil {
+			return item, err
+		}
+	default:
+		if err := gob.NewDecoder(bytes.NewReader(b)).DecodeValue(v); err != nil {
+			return item, err
+		}
+	}
+	return item, nil
+}
+
+// compareOrdered compares two strings or numbers of the kind described by c.
+func compareOrdered(c codec, a, b reflect.Value) int {
+	switch c {
+	case codecString:
+		return cmp.Compare(a.String(), b.String())
+	case codecInt:
+		return cmp.Compare(a.Int(), b.Int())
+	case codecUint:
+		return cmp.Compare(a.Uint(), b.Uint())
+	default:
+		return cmp.Compare(a.Float(), b.Float())
+	}
+}
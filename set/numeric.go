@@ -0,0 +1,64 @@
+package set
+
+import (
+	"cmp"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// Number is a constraint for the numeric types supported by Sum and Product.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Sum returns the sum of all elements of the set, or 0 if the set is empty.
+func Sum[T Number](s Set[T]) T {
+	var sum T
+	for item := range s.items {
+		sum += item
+	}
+	return sum
+}
+
+// Product returns the product of all elements of the set, or 1 if the set is empty.
+func Product[T Number](s Set[T]) T {
+	var product T = 1
+	for item := range s.items {
+		product *= item
+	}
+	return product
+}
+
+// MinOf returns the smallest element of the set, or None if the set is empty.
+func MinOf[T cmp.Ordered](s Set[T]) optional.Option[T] {
+	first := true
+	var result T
+	for item := range s.items {
+		if first || item < result {
+			result = item
+			first = false
+		}
+	}
+	if first {
+		return optional.None[T]()
+	}
+	return optional.Some(result)
+}
+
+// MaxOf returns the largest element of the set, or None if the set is empty.
+func MaxOf[T cmp.Ordered](s Set[T]) optional.Option[T] {
+	first := true
+	var result T
+	for item := range s.items {
+		if first || item > result {
+			result = item
+			first = false
+		}
+	}
+	if first {
+		return optional.None[T]()
+	}
+	return optional.Some(result)
+}
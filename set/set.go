@@ -3,6 +3,7 @@ package set
 import (
 	"fmt"
 	"iter"
+	"reflect"
 	"strings"
 )
 
@@ -19,9 +20,22 @@ func New[T comparable]() Set[T] {
 	}
 }
 
+// withCapacity creates a new empty Set with room for n elements.
+func withCapacity[T comparable](n int) Set[T] {
+	return Set[T]{
+		items: make(map[T]struct{}, n),
+	}
+}
+
+// sameItems reports whether both sets share the same underlying map,
+// which is the case for copies of the same Set value.
+func (s *Set[T]) sameItems(other Set[T]) bool {
+	return reflect.ValueOf(s.items).UnsafePointer() == reflect.ValueOf(other.items).UnsafePointer()
+}
+
 // FromSlice creates a new Set containing all unique elements from the given slice.
 func FromSlice[T comparable](slice []T) Set[T] {
-	s := New[T]()
+	s := withCapacity[T](len(slice))
 	for _, item := range slice {
 		s.Add(item)
 	}
@@ -115,22 +129,33 @@ func (s *Set[T]) Range(fn func(T) bool) {
 
 // Union returns a new set containing all elements from both sets.
 func (s *Set[T]) Union(other Set[T]) Set[T] {
-	result := New[T]()
+	if s.sameItems(other) {
+		return s.Clone()
+	}
+	result := withCapacity[T](max(len(s.items), len(other.items)))
 	for item := range s.items {
-		result.Add(item)
+		result.items[item] = struct{}{}
 	}
 	for item := range other.items {
-		result.Add(item)
+		result.items[item] = struct{}{}
 	}
 	return result
 }
 
 // Intersection returns a new set containing only elements present in both sets.
+// It iterates over the smaller of the two sets.
 func (s *Set[T]) Intersection(other Set[T]) Set[T] {
-	result := New[T]()
-	for item := range s.items {
-		if other.Contains(item) {
-			result.Add(item)
+	if s.sameItems(other) {
+		return s.Clone()
+	}
+	small, large := s.items, other.items
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	result := withCapacity[T](len(small))
+	for item := range small {
+		if _, ok := large[item]; ok {
+			result.items[item] = struct{}{}
 		}
 	}
 	return result
@@ -138,6 +163,9 @@ func (s *Set[T]) Intersection(other Set[T]) Set[T] {
 
 // Difference returns a new set containing elements in this set but not in the other set.
 func (s *Set[T]) Difference(other Set[T]) Set[T] {
+	if s.sameItems(other) {
+		return New[T]()
+	}
 	result := New[T]()
 	for item := range s.items {
 		if !other.Contains(item) {
@@ -165,6 +193,12 @@ func (s *Set[T]) SymmetricDifference(other Set[T]) Set[T] {
 
 // IsSubset returns true if all elements of this set are in the other set.
 func (s *Set[T]) IsSubset(other Set[T]) bool {
+	if s.sameItems(other) {
+		return true
+	}
+	if len(s.items) > len(other.items) {
+		return false
+	}
 	for item := range s.items {
 		if !other.Contains(item) {
 			return false
@@ -183,6 +217,9 @@ func (s *Set[T]) Equal(other Set[T]) bool {
 	if s.Size() != other.Size() {
 		return false
 	}
+	if s.sameItems(other) {
+		return true
+	}
 	for item := range s.items {
 		if !other.Contains(item) {
 			return false
@@ -194,9 +231,9 @@ func (s *Set[T]) Equal(other Set[T]) bool {
 // Clone creates a deep copy of the Set with an independent internal map.
 // Modifications to the clone will not affect the original Set and vice versa.
 func (s *Set[T]) Clone() Set[T] {
-	clone := New[T]()
+	clone := withCapacity[T](len(s.items))
 	for item := range s.items {
-		clone.Add(item)
+		clone.items[item] = struct{}{}
 	}
 	return clone
 }
@@ -0,0 +1,117 @@
+package set
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+const benchSize = 1_000_000
+
+func benchSet(n, offset int) Set[int] {
+	s := withCapacity[int](n)
+	for i := range n {
+		s.Add(i + offset)
+	}
+	return s
+}
+
+func TestEncodeDecode(t *testing.T) {
+	a := FromSlice([]int{5, -3, 100, 0})
+	b := FromSlice([]int{100, 0, 5, -3})
+
+	var bufA, bufB bytes.Buffer
+	if err := a.Encode(&bufA); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := b.Encode(&bufB); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !bytes.Equal(bufA.Bytes(), bufB.Bytes()) {
+		t.Error("expected equal sets to encode to identical bytes")
+	}
+
+	other := FromSlice([]string{"x"})
+	other.Encode(&bufA)
+
+	decoded := New[int]()
+	if err := decoded.Decode(&bufA); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !decoded.Equal(a) {
+		t.Errorf("expected %v, got %v", a, decoded)
+	}
+	if err := decoded.Decode(&bufA); !errors.Is(err, ErrEncoding) {
+		t.Errorf("expected ErrEncoding decoding strings into a set of ints, got %v", err)
+	}
+}
+
+func TestEncodeDecodeStruct(t *testing.T) {
+	type point struct{ X, Y int }
+	s := FromSlice([]point{{1, 2}, {3, 4}})
+	times := FromSlice([]time.Time{time.Unix(0, 0).UTC(), time.Unix(60, 0).UTC()})
+
+	var buf bytes.Buffer
+	s.Encode(&buf)
+	times.Encode(&buf)
+
+	decoded := New[point]()
+	decodedTimes := New[time.Time]()
+	if err := decoded.Decode(&buf); err != nil || !decoded.Equal(s) {
+		t.Errorf("expected %v, got %v, %v", s, decoded, err)
+	}
+	if err := decodedTimes.Decode(&buf); err != nil || decodedTimes.Size() != 2 || !decodedTimes.Contains(time.Unix(60, 0).UTC()) {
+		t.Errorf("expected %v, got %v, %v", times, decodedTimes, err)
+	}
+}
+
+func BenchmarkEqualSameSet(b *testing.B) {
+	s := benchSet(benchSize, 0)
+	alias := s
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Equal(alias)
+	}
+}
+
+func BenchmarkEqualDistinctSets(b *testing.B) {
+	s := benchSet(benchSize, 0)
+	other := s.Clone()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Equal(other)
+	}
+}
+
+func BenchmarkIsSubsetLargerSet(b *testing.B) {
+	s := benchSet(benchSize, 0)
+	other := benchSet(benchSize/2, 0)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.IsSubset(other)
+	}
+}
+
+func BenchmarkUnion(b *testing.B) {
+	s := benchSet(benchSize, 0)
+	other := benchSet(benchSize, benchSize/2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Union(other)
+	}
+}
+
+func BenchmarkIntersectionSmallWithLarge(b *testing.B) {
+	s := benchSet(benchSize, 0)
+	small := benchSet(1_000, benchSize/2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Intersection(small)
+	}
+}
@@ -0,0 +1,38 @@
+package stream
+
+import (
+	"github.com/marouanesouiri/stdx/mmap"
+	"github.com/marouanesouiri/stdx/omap"
+	"github.com/marouanesouiri/stdx/set"
+)
+
+// FromSet creates a Stream from the elements of a Set, in unspecified order.
+func FromSet[T comparable](s set.Set[T]) Stream[T] {
+	return Stream[T]{seq: s.Seq()}
+}
+
+// FromOmapKeys creates a Stream from the keys of an OrderedMap, in insertion order.
+func FromOmapKeys[K comparable, V any](m *omap.OrderedMap[K, V]) Stream[K] {
+	return FromOrderedMap(m).Keys()
+}
+
+// FromOmapValues creates a Stream from the values of an OrderedMap, in key insertion order.
+func FromOmapValues[K comparable, V any](m *omap.OrderedMap[K, V]) Stream[V] {
+	return FromOrderedMap(m).Values()
+}
+
+// FromOmapItems creates a Stream with one Item for every entry of an OrderedMap, in insertion order.
+// Use FromOrderedMap for a Stream2 over the same pairs.
+func FromOmapItems[K comparable, V any](m *omap.OrderedMap[K, V]) Stream[omap.Item[K, V]] {
+	return ToStream(FromOrderedMap(m), func(k K, v V) omap.Item[K, V] {
+		return omap.Item[K, V]{Key: k, Value: v}
+	})
+}
+
+// FromMultimapEntries creates a Stream with one Entry for every key-value association in a Multimap.
+// Use FromMultimap for a Stream2 over the same pairs.
+func FromMultimapEntries[K comparable, V comparable](m *mmap.Multimap[K, V]) Stream[mmap.Entry[K, V]] {
+	return ToStream(FromMultimap(m), func(k K, v V) mmap.Entry[K, V] {
+		return mmap.Entry[K, V]{Key: k, Value: v}
+	})
+}
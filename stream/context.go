@@ -0,0 +1,63 @@
+package stream
+
+import "context"
+
+// FromChannelCtx creates a Stream from a channel that ends when the channel is closed
+// or when ctx is done, whichever happens first. Unlike FromChannel, it never blocks
+// past cancellation waiting for a value that may never come.
+func FromChannelCtx[T any](ctx context.Context, ch <-chan T) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-ch:
+					if !ok || !yield(v) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+// WithContext returns a Stream that ends as soon as ctx is done.
+// The context is checked before each element is passed on, so infinite streams built
+// with Generate or Iterate can be shut down cleanly.
+func (s Stream[T]) WithContext(ctx context.Context) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for v := range s.seq {
+				if ctx.Err() != nil || !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ForEachCtx executes an action for each element until the stream ends or ctx is done.
+// It returns ctx.Err() if it stopped because of the context, nil otherwise.
+func (s Stream[T]) ForEachCtx(ctx context.Context, action func(T)) error {
+	for v := range s.seq {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		action(v)
+	}
+	return ctx.Err()
+}
+
+// ToSliceCtx collects elements into a slice until the stream ends or ctx is done.
+// If ctx is done first, it returns the elements collected so far together with ctx.Err().
+func (s Stream[T]) ToSliceCtx(ctx context.Context) ([]T, error) {
+	result := make([]T, 0)
+	for v := range s.seq {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		result = append(result, v)
+	}
+	return result, ctx.Err()
+}
@@ -34,6 +34,12 @@
 //	// From iter.Seq
 //	s5 := stream.FromSeq(someIterSeq)
 //
+//	// From stdx containers
+//	tags := stream.FromSet(tagSet)
+//	names := stream.FromOmapValues(&byID)
+//	entries := stream.FromOmapItems(&byID)
+//	pairs := stream.FromMultimapEntries(&index)
+//
 //	// Empty stream
 //	s6 := stream.Empty[int]()
 //
@@ -41,6 +47,18 @@
 //	s7 := stream.Generate(func() int { return rand.Intn(100) })
 //	s8 := stream.Iterate(0, func(n int) int { return n + 1 })
 //
+//	// Reproducible random stream: the same seed always gives the same elements
+//	s9 := stream.GenerateSeeded(42, func(r *rand.Rand) int { return r.Intn(100) }).Limit(10)
+//
+//	// Finite stateful generation, e.g. following pagination cursors
+//	s10 := stream.Unfold("", func(cursor string) (Page, string, bool) {
+//	    if cursor == "end" {
+//	        return Page{}, "", false
+//	    }
+//	    page := fetchPage(cursor)
+//	    return page, page.Next, true
+//	})
+//
 // # Intermediate Operations
 //
 // Intermediate operations return a new Stream and are lazily evaluated:
@@ -55,16 +73,31 @@
 //   - Filter: Keep elements matching predicate
 //   - Map: Transform each element
 //   - FlatMap: Transform and flatten nested streams
+//   - FlatMapSeq, FlatMapSlice: Flatten an iter.Seq or slice per element without wrapping it in a Stream
 //   - Distinct: Remove duplicates
 //   - DistinctBy: Remove duplicates by key function
 //   - Sorted: Sort elements
+//   - SortedStable: Sort elements, keeping the order of equal elements
+//   - SortedBy: Sort elements by an extracted cmp.Ordered key (stable)
+//   - SortedExternal: Sort elements using temporary files, for streams larger than memory
+//   - TopN, BottomN: Keep the n largest or smallest elements, in sorted order, using a bounded heap
 //   - Peek: Perform action without modification
 //   - Limit: Take first n elements
 //   - Skip: Skip first n elements
 //   - TakeWhile: Take while predicate is true
 //   - DropWhile: Drop while predicate is true
 //   - Concat: Concatenate with another stream
+//   - ZipWith: Pair two streams element by element, stopping at the shorter one
+//   - Zip: Like ZipWith, but keeps each pair in a Stream2
+//   - Interleave: Take one element from each stream in turn
+//   - Merge: Consume several streams concurrently and yield elements as they arrive
 //   - Reverse: Reverse element order
+//   - Sample: Keep each element with probability p
+//   - Shuffle: Random order (materializes the stream)
+//   - Chunk: Group elements into slices of n elements
+//   - Window: Sliding windows of size elements, advancing by step
+//   - GroupByStreaming: Group runs of consecutive elements with the same key, lazily
+//   - Buffer: Read up to n elements ahead of the consumer in a separate goroutine
 //
 // # Terminal Operations
 //
@@ -89,10 +122,20 @@
 //	    Filter(func(x int) bool { return x > 3 }).
 //	    FindFirst()  // Some(4)
 //
+//	// Pick k elements uniformly at random in one pass
+//	picks := stream.From(data).ReservoirSample(3, nil)
+//
 //	// Check conditions
 //	allEven := stream.From(data).AllMatch(func(x int) bool { return x%2 == 0 })
 //	hasEven := stream.From(data).AnyMatch(func(x int) bool { return x%2 == 0 })
 //
+// Numeric streams have dedicated terminals that need no collector:
+//
+//	total := stream.SumOf(stream.From(prices))
+//	mean := stream.AverageOf(stream.From(latencies)) // None if empty
+//	bounds := stream.MinMaxOf(stream.From(temps))   // Some(MinMax{Min, Max}) in one pass
+//	exact := stream.SumOfBig(stream.From(bytes))    // *big.Int, cannot overflow
+//
 // # Using Collectors
 //
 // Collectors provide reusable reduction operations from the collectors package:
@@ -111,6 +154,9 @@
 //	    collectors.GroupingBy(func(s string) int { return len(s) }),
 //	)
 //
+//	// Group and aggregate in one pass
+//	counts := stream.GroupByTo(stream.From(words), func(s string) int { return len(s) }, collectors.Counting[string]())
+//
 //	// Collect statistics
 //	stats := stream.Collect(
 //	    stream.From(numbers),
@@ -127,6 +173,22 @@
 //	    func(n int) string { return strconv.Itoa(n) },
 //	).ToSlice()  // ["1", "2", "3", "4", "5"]
 //
+// # Key-Value Streams
+//
+// Stream2 wraps an iter.Seq2 for pipelines over key-value pairs, without packing them into a struct:
+//
+//	inStock := stream.CollectMap(stream.FromMap(stock).
+//	    FilterValues(func(n int) bool { return n > 0 }))
+//
+//	// Sum order totals per customer
+//	totals := stream.ReduceByKey(
+//	    stream.MapValuesTo(stream.KeyBy(stream.From(orders), Order.Customer), Order.Total),
+//	    func(a, b float64) float64 { return a + b },
+//	)
+//
+// Stream2 can be created from maps, omap.OrderedMap, cmap.ConcurrentMap and mmap.Multimap
+// with FromMap, FromOrderedMap, FromConcurrentMap and FromMultimap.
+//
 // # Lazy Evaluation
 //
 // Streams use lazy evaluation - intermediate operations build a pipeline
@@ -164,6 +226,25 @@
 //	    return result
 //	}
 //
+// # Short-Circuiting
+//
+// Streams are pull-based: each element flows through the whole pipeline before the next one
+// is requested. Operations that can stop early (Limit, TakeWhile, FindFirst, AnyMatch, AllMatch,
+// NoneMatch, ...) stop pulling from the source as soon as their result is known, including
+// through Concat, FlatMap, Chunk and ZipWith, so they are safe to use on infinite streams:
+//
+//	// Calls expensive exactly 3 times
+//	stream.Generate(expensive).Limit(3).ToSlice()
+//
+// Collect and CollectTo do the same for collectors implementing collectors.ShortCircuiting,
+// such as collectors.First, collectors.AnyMatch and collectors.AllMatch:
+//
+//	stream.CollectTo(stream.Generate(expensive), collectors.First[Result]())
+//
+// The exceptions are operations that read ahead on purpose: ParallelMap, ParallelMapUnordered,
+// Buffer and Merge consume the source from other goroutines and may pull a bounded number of
+// elements that are never used. WithContext pulls one element before noticing cancellation.
+//
 // # Performance Considerations
 //
 // Streams are designed for readability and functional composition. For maximum performance
@@ -173,6 +254,90 @@
 //   - Using Limit() early to reduce processing
 //   - Being aware that operations like Sorted() and Distinct() must materialize the entire stream
 //
+// # Cancellation
+//
+// Infinite and channel-backed streams can be bound to a context.Context:
+//
+//	// Ends when ch is closed or ctx is done
+//	events := stream.FromChannelCtx(ctx, ch)
+//
+//	// Ends when ctx is done
+//	samples := stream.Generate(readSensor).WithContext(ctx)
+//
+//	// Terminal operations that report why they stopped
+//	err := samples.ForEachCtx(ctx, record)
+//	batch, err := events.ToSliceCtx(ctx)
+//
+// # Error Handling
+//
+// TryMapTo applies a function that may fail and turns each outcome into a result.Result,
+// so errors travel down the stream instead of forcing a panic:
+//
+//	configs, err := stream.CollectOrError(
+//	    stream.TryMapTo(stream.From(paths), loadConfig),
+//	) // stops reading paths at the first error
+//
+//	err = stream.ForEachOrError(stream.TryMapTo(stream.FromChannel(jobs), prepare), submit)
+//
+// The results are ordinary elements, so Filter or MapTo can also drop or recover errors.
+//
+// # Parallel Execution
+//
+// CPU-bound or I/O-bound mapping can be spread over a bounded pool of workers:
+//
+//	// Keeps the source order
+//	thumbs := stream.ParallelMap(stream.From(images), 8, makeThumbnail).ToSlice()
+//
+//	// Yields results as soon as they are ready
+//	pages := stream.ParallelMapUnordered(stream.From(urls), 16, fetch)
+//
+//	// Runs the action concurrently and waits for all of them
+//	stream.From(jobs).ParallelForEach(4, process)
+//
+//	// Each worker accumulates part of the elements; the partial results are combined
+//	scores := stream.ParallelCollectTo(stream.From(docs), 8,
+//	    collectors.GroupingByWith(language, collectors.Mapping(score, collectors.Averaging(identity))))
+//
+// The mapper or action must be safe for concurrent use. Passing 0 workers uses runtime.GOMAXPROCS(0).
+//
+// # Batching
+//
+// Chunk and Window group elements into slices, for example to batch database writes:
+//
+//	stream.Chunk(stream.FromChannel(rows), 500).ForEach(func(batch []Row) {
+//	    db.InsertMany(batch)
+//	})
+//
+//	// Moving average over the last 5 samples
+//	avgs := stream.MapTo(stream.Window(stream.From(samples), 5, 1), mean)
+//
+// GroupByStreaming groups input that is already clustered by key without building a map,
+// yielding each group as a Stream:
+//
+//	stream.GroupByStreaming(stream.FromChannel(logLines), LogLine.RequestID).
+//	    ForEach(func(id string, lines stream.Stream[LogLine]) {
+//	        fmt.Println(id, lines.Count())
+//	    })
+//
+// Buffer lets the producer run ahead of a slow consumer:
+//
+//	stream.Generate(fetchPage).Buffer(8).ForEach(store)
+//
+// # Memory Usage
+//
+// Most operations process one element at a time and use constant memory.
+// The following operations hold the whole stream (or all distinct elements) in memory:
+//   - Sorted, SortedWith, SortedStable, SortedBy (use TopN or BottomN when only the first n are needed)
+//   - Reverse, Shuffle
+//   - Distinct, DistinctBy (keep every distinct element or key seen so far)
+//   - Terminal operations building collections: ToSlice, Collect, ToMap, GroupBy, PartitionBy
+//
+// For inputs larger than memory, SortedExternal keeps at most chunkSize elements in memory,
+// spilling sorted chunks to temporary files and merging them lazily:
+//
+//	sorted := stream.FromChannel(records).
+//	    SortedExternal(func(a, b Record) bool { return a.ID < b.ID }, "", 100_000)
+//
 // # Examples
 //
 // Filter and transform:
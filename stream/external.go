@@ -0,0 +1,153 @@
+package stream
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// SortedExternal returns a Stream with elements sorted according to the less function,
+// without holding more than chunkSize elements in memory at once.
+//
+// Elements are read in chunks of chunkSize, each chunk is sorted and written to a temporary
+// file in tmpDir (os.TempDir if empty) with encoding/gob, and the chunks are then merged
+// lazily. If the whole stream fits in one chunk, it is sorted in memory and no file is created.
+// Temporary files are removed once the result is fully consumed or iteration stops early.
+//
+// T must be encodable with encoding/gob. The sort is not stable.
+// Panics if a temporary file cannot be written or read back.
+func (s Stream[T]) SortedExternal(less func(T, T) bool, tmpDir string, chunkSize int) Stream[T] {
+	if chunkSize <= 0 {
+		chunkSize = 1 << 16
+	}
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			var files []*os.File
+			defer func() {
+				for _, f := range files {
+					f.Close()
+					os.Remove(f.Name())
+				}
+			}()
+
+			chunk := make([]T, 0, chunkSize)
+			flush := func() {
+				sort.Slice(chunk, func(i, j int) bool { return less(chunk[i], chunk[j]) })
+				f, err := writeChunk(tmpDir, chunk)
+				if f != nil {
+					files = append(files, f)
+				}
+				if err != nil {
+					panic(fmt.Errorf("stream: external sort: %w", err))
+				}
+				chunk = chunk[:0]
+			}
+
+			for v := range s.seq {
+				chunk = append(chunk, v)
+				if len(chunk) == chunkSize {
+					flush()
+				}
+			}
+
+			if len(files) == 0 {
+				sort.Slice(chunk, func(i, j int) bool { return less(chunk[i], chunk[j]) })
+				for _, v := range chunk {
+					if !yield(v) {
+						return
+					}
+				}
+				return
+			}
+			if len(chunk) > 0 {
+				flush()
+			}
+
+			mergeChunks(files, less, yield)
+		},
+	}
+}
+
+// writeChunk encodes a sorted chunk into a new temporary file and rewinds it for reading.
+func writeChunk[T any](dir string, chunk []T) (*os.File, error) {
+	f, err := os.CreateTemp(dir, "stream-sort-*")
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(f)
+	enc := gob.NewEncoder(w)
+	for i := range chunk {
+		if err := enc.Encode(&chunk[i]); err != nil {
+			return f, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return f, err
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	return f, err
+}
+
+// chunkReader decodes the elements of one sorted chunk file.
+type chunkReader[T any] struct {
+	dec  *gob.Decoder
+	head T
+}
+
+// next decodes the next element into head. It returns false at the end of the chunk.
+func (r *chunkReader[T]) next() bool {
+	var v T
+	if err := r.dec.Decode(&v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return false
+		}
+		panic(fmt.Errorf("stream: external sort: %w", err))
+	}
+	r.head = v
+	return true
+}
+
+// chunkHeap orders chunk readers by their current head element.
+type chunkHeap[T any] struct {
+	readers []*chunkReader[T]
+	less    func(T, T) bool
+}
+
+func (h *chunkHeap[T]) Len() int           { return len(h.readers) }
+func (h *chunkHeap[T]) Less(i, j int) bool { return h.less(h.readers[i].head, h.readers[j].head) }
+func (h *chunkHeap[T]) Swap(i, j int)      { h.readers[i], h.readers[j] = h.readers[j], h.readers[i] }
+func (h *chunkHeap[T]) Push(x any)         { h.readers = append(h.readers, x.(*chunkReader[T])) }
+func (h *chunkHeap[T]) Pop() any {
+	last := h.readers[len(h.readers)-1]
+	h.readers = h.readers[:len(h.readers)-1]
+	return last
+}
+
+// mergeChunks performs a k-way merge of the sorted chunk files.
+func mergeChunks[T any](files []*os.File, less func(T, T) bool, yield func(T) bool) {
+	h := &chunkHeap[T]{less: less}
+	for _, f := range files {
+		r := &chunkReader[T]{dec: gob.NewDecoder(bufio.NewReader(f))}
+		if r.next() {
+			h.readers = append(h.readers, r)
+		}
+	}
+	heap.Init(h)
+
+	for h.Len() > 0 {
+		r := h.readers[0]
+		if !yield(r.head) {
+			return
+		}
+		if r.next() {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+}
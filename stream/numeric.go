@@ -0,0 +1,81 @@
+package stream
+
+import (
+	"cmp"
+	"math/big"
+
+	"github.com/marouanesouiri/stdx/collectors"
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// Integer is a constraint for the integer types supported by SumOfBig.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// MinMax holds the smallest and largest elements of a stream.
+type MinMax[N any] struct {
+	Min N
+	Max N
+}
+
+// SumOf returns the sum of all elements, or 0 for an empty stream.
+// Integer sums wrap around on overflow like Go arithmetic; use SumOfBig when that can happen.
+func SumOf[N collectors.Number](s Stream[N]) N {
+	var sum N
+	for v := range s.seq {
+		sum += v
+	}
+	return sum
+}
+
+// SumOfBig returns the exact sum of all integer elements as a big.Int, so it cannot overflow.
+func SumOfBig[N Integer](s Stream[N]) *big.Int {
+	signed := ^N(0) < 0
+	sum := new(big.Int)
+	var x big.Int
+	for v := range s.seq {
+		if signed {
+			x.SetInt64(int64(v))
+		} else {
+			x.SetUint64(uint64(v))
+		}
+		sum.Add(sum, &x)
+	}
+	return sum
+}
+
+// AverageOf returns the arithmetic mean of all elements, or None for an empty stream.
+// Elements are accumulated as float64.
+func AverageOf[N collectors.Number](s Stream[N]) optional.Option[float64] {
+	var sum float64
+	var count int64
+	for v := range s.seq {
+		sum += float64(v)
+		count++
+	}
+	if count == 0 {
+		return optional.None[float64]()
+	}
+	return optional.Some(sum / float64(count))
+}
+
+// MinMaxOf returns the smallest and largest elements in a single pass, or None for an empty stream.
+func MinMaxOf[N cmp.Ordered](s Stream[N]) optional.Option[MinMax[N]] {
+	var result MinMax[N]
+	first := true
+	for v := range s.seq {
+		if first {
+			result = MinMax[N]{Min: v, Max: v}
+			first = false
+			continue
+		}
+		result.Min = min(result.Min, v)
+		result.Max = max(result.Max, v)
+	}
+	if first {
+		return optional.None[MinMax[N]]()
+	}
+	return optional.Some(result)
+}
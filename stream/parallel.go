@@ -0,0 +1,246 @@
+package stream
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/marouanesouiri/stdx/collectors"
+)
+
+// parallelJob carries an element to a worker along with the channel receiving its result.
+type parallelJob[T, U any] struct {
+	value  T
+	result chan U
+}
+
+// ParallelMap transforms each element using the mapper function on a pool of worker goroutines,
+// keeping the order of the source stream.
+// At most workers elements are mapped concurrently; if workers is not positive, runtime.GOMAXPROCS(0) is used.
+//
+// The source is consumed from a separate goroutine, a bounded number of elements ahead of the consumer.
+// If the consumer stops early, in-flight elements are finished and discarded before the stream returns.
+// The mapper must be safe for concurrent use.
+func ParallelMap[T, U any](s Stream[T], workers int, mapper func(T) U) Stream[U] {
+	workers = parallelWorkers(workers)
+	return Stream[U]{
+		seq: func(yield func(U) bool) {
+			jobs := make(chan parallelJob[T, U])
+			pending := make(chan chan U, workers)
+			done := make(chan struct{})
+
+			var wg sync.WaitGroup
+			wg.Add(workers + 1)
+			go func() {
+				defer wg.Done()
+				defer close(pending)
+				defer close(jobs)
+				for v := range s.seq {
+					result := make(chan U, 1)
+					select {
+					case pending <- result:
+					case <-done:
+						return
+					}
+					select {
+					case jobs <- parallelJob[T, U]{value: v, result: result}:
+					case <-done:
+						return
+					}
+				}
+			}()
+			for range workers {
+				go func() {
+					defer wg.Done()
+					for job := range jobs {
+						job.result <- mapper(job.value)
+					}
+				}()
+			}
+			defer wg.Wait()
+			defer close(done)
+
+			for result := range pending {
+				if !yield(<-result) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ParallelMapUnordered is like ParallelMap but yields results as soon as they are ready,
+// in no particular order. It avoids waiting on slow elements and suits pipelines where order does not matter.
+func ParallelMapUnordered[T, U any](s Stream[T], workers int, mapper func(T) U) Stream[U] {
+	workers = parallelWorkers(workers)
+	return Stream[U]{
+		seq: func(yield func(U) bool) {
+			jobs := make(chan T)
+			out := make(chan U, workers)
+			done := make(chan struct{})
+
+			var wg sync.WaitGroup
+			wg.Add(workers + 1)
+			go func() {
+				defer wg.Done()
+				defer close(jobs)
+				for v := range s.seq {
+					select {
+					case jobs <- v:
+					case <-done:
+						return
+					}
+				}
+			}()
+			for range workers {
+				go func() {
+					defer wg.Done()
+					for v := range jobs {
+						select {
+						case out <- mapper(v):
+						case <-done:
+						}
+					}
+				}()
+			}
+			go func() {
+				wg.Wait()
+				close(out)
+			}()
+
+			defer func() {
+				close(done)
+				for range out {
+				}
+			}()
+
+			for u := range out {
+				if !yield(u) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ParallelForEach executes an action for each element on a pool of worker goroutines
+// and returns once every action has completed.
+// At most workers actions run concurrently; if workers is not positive, runtime.GOMAXPROCS(0) is used.
+// The action must be safe for concurrent use.
+func (s Stream[T]) ParallelForEach(workers int, action func(T)) {
+	workers = parallelWorkers(workers)
+	jobs := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for v := range jobs {
+				action(v)
+			}
+		}()
+	}
+
+	for v := range s.seq {
+		jobs <- v
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// ParallelCollectTo gathers stream elements like CollectTo, but accumulates them on a pool of worker
+// goroutines, each with its own accumulator, and merges the partial accumulators with the collector's
+// Combine method before finishing. It pays off when accumulating is expensive, e.g. with a costly
+// mapper inside collectors.Mapping or GroupingByWith.
+// If workers is not positive, runtime.GOMAXPROCS(0) is used.
+//
+// Elements reach the accumulators in no particular order, so order-sensitive collectors such as
+// ToSlice or Joining produce an unspecified order. The whole stream is consumed, even for
+// short-circuiting collectors. If the collector cannot combine accumulators (see collectors.Parallel),
+// the stream is collected sequentially with CollectTo instead.
+func ParallelCollectTo[T, A, R any](s Stream[T], workers int, collector collectors.Collector[T, A, R]) R {
+	pc, ok := collectors.Parallel(collector)
+	if !ok {
+		return CollectTo(s, collector)
+	}
+	workers = parallelWorkers(workers)
+	jobs := make(chan T)
+	partials := make([]A, workers)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := range workers {
+		go func() {
+			defer wg.Done()
+			acc := pc.Supplier()
+			for v := range jobs {
+				acc = pc.Accumulator(acc, v)
+			}
+			partials[i] = acc
+		}()
+	}
+
+	for v := range s.seq {
+		jobs <- v
+	}
+	close(jobs)
+	wg.Wait()
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = pc.Combine(acc, partial)
+	}
+	return pc.Finisher(acc)
+}
+
+// Merge combines streams by consuming each one in its own goroutine and yielding elements
+// as soon as any stream produces them. It suits channel-backed streams fed by independent sources.
+// The order between streams is unspecified; the order within each stream is kept.
+// If the consumer stops early, Merge waits for the element each stream is producing before returning.
+func Merge[T any](streams ...Stream[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			out := make(chan T, len(streams))
+			done := make(chan struct{})
+
+			var wg sync.WaitGroup
+			wg.Add(len(streams))
+			for _, s := range streams {
+				go func() {
+					defer wg.Done()
+					for v := range s.seq {
+						select {
+						case out <- v:
+						case <-done:
+							return
+						}
+					}
+				}()
+			}
+			go func() {
+				wg.Wait()
+				close(out)
+			}()
+
+			defer func() {
+				close(done)
+				for range out {
+				}
+			}()
+
+			for v := range out {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// parallelWorkers returns the number of workers to use for a requested count.
+func parallelWorkers(workers int) int {
+	if workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return workers
+}
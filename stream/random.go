@@ -0,0 +1,69 @@
+package stream
+
+import "math/rand"
+
+// randFloat64 and randIntn use rng, or the global source if rng is nil.
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
+
+func randIntn(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.Intn(n)
+	}
+	return rng.Intn(n)
+}
+
+// Sample returns a Stream keeping each element independently with probability p,
+// so about p of the elements pass. It works on infinite streams.
+// Random numbers come from rng, or from the global source if rng is nil;
+// pass a seeded source for reproducible samples.
+func (s Stream[T]) Sample(p float64, rng *rand.Rand) Stream[T] {
+	return s.Filter(func(T) bool {
+		return randFloat64(rng) < p
+	})
+}
+
+// Shuffle returns a Stream with the elements in random order, each permutation being equally likely.
+// Random numbers come from rng, or from the global source if rng is nil.
+// This operation materializes the entire stream into memory.
+func (s Stream[T]) Shuffle(rng *rand.Rand) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			slice := s.ToSlice()
+			for i := len(slice) - 1; i > 0; i-- {
+				j := randIntn(rng, i+1)
+				slice[i], slice[j] = slice[j], slice[i]
+			}
+			for _, v := range slice {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ReservoirSample returns k elements chosen uniformly at random from the stream, in a single pass
+// and keeping only k elements in memory. If the stream has k elements or fewer, all of them are returned.
+// The order of the returned elements is unspecified.
+// Random numbers come from rng, or from the global source if rng is nil.
+func (s Stream[T]) ReservoirSample(k int, rng *rand.Rand) []T {
+	reservoir := make([]T, 0, max(k, 0))
+	if k <= 0 {
+		return reservoir
+	}
+	seen := 0
+	for v := range s.seq {
+		seen++
+		if len(reservoir) < k {
+			reservoir = append(reservoir, v)
+		} else if j := randIntn(rng, seen); j < k {
+			reservoir[j] = v
+		}
+	}
+	return reservoir
+}
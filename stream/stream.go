@@ -1,7 +1,9 @@
 package stream
 
 import (
+	"cmp"
 	"iter"
+	"math/rand"
 	"sort"
 
 	"github.com/marouanesouiri/stdx/collectors"
@@ -98,6 +100,22 @@ func Generate[T any](supplier func() T) Stream[T] {
 	}
 }
 
+// GenerateSeeded creates an infinite Stream by repeatedly calling fn with a random source seeded with seed.
+// Every traversal of the stream starts from a fresh source, so the same seed always yields the same
+// sequence, which makes property-based tests and simulations reproducible. Use Limit to take a finite prefix.
+func GenerateSeeded[T any](seed int64, fn func(*rand.Rand) T) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			rng := rand.New(rand.NewSource(seed))
+			for {
+				if !yield(fn(rng)) {
+					return
+				}
+			}
+		},
+	}
+}
+
 // Iterate creates an infinite Stream by applying a function to a seed value iteratively.
 func Iterate[T any](seed T, fn func(T) T) Stream[T] {
 	return Stream[T]{
@@ -113,6 +131,41 @@ func Iterate[T any](seed T, fn func(T) T) Stream[T] {
 	}
 }
 
+// Unfold creates a Stream from a seed state. fn is called with the current state and returns
+// the next element, the next state, and whether an element was produced; the stream ends
+// the first time it returns false. Unlike Iterate, the stream can terminate, which suits
+// pagination cursors and tokenizers.
+func Unfold[S, T any](seed S, fn func(S) (T, S, bool)) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			state := seed
+			for {
+				v, next, ok := fn(state)
+				if !ok || !yield(v) {
+					return
+				}
+				state = next
+			}
+		},
+	}
+}
+
+// FromFunc creates a Stream by repeatedly calling next until it returns false.
+// State can be kept in variables captured by the closure; every traversal of the
+// stream shares that state, so use Unfold when the stream must be replayable.
+func FromFunc[T any](next func() (T, bool)) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for {
+				v, ok := next()
+				if !ok || !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
 // Seq returns the underlying iter.Seq for use with for-range loops.
 func (s Stream[T]) Seq() iter.Seq[T] {
 	return s.seq
@@ -189,6 +242,38 @@ func FlatMapTo[T, U any](s Stream[T], mapper func(T) Stream[U]) Stream[U] {
 	}
 }
 
+// FlatMapSeq transforms each element to an iter.Seq and flattens the results.
+// It avoids wrapping each result in a Stream when the mapper already produces a sequence,
+// such as maps.Keys or a container's Seq method.
+func FlatMapSeq[T, U any](s Stream[T], mapper func(T) iter.Seq[U]) Stream[U] {
+	return Stream[U]{
+		seq: func(yield func(U) bool) {
+			for v := range s.seq {
+				for u := range mapper(v) {
+					if !yield(u) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+// FlatMapSlice transforms each element to a slice and flattens the results.
+func FlatMapSlice[T, U any](s Stream[T], mapper func(T) []U) Stream[U] {
+	return Stream[U]{
+		seq: func(yield func(U) bool) {
+			for v := range s.seq {
+				for _, u := range mapper(v) {
+					if !yield(u) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
 // Distinct returns a Stream with duplicate elements removed.
 // T must be a comparable type. For non-comparable types, use DistinctBy.
 func (s Stream[T]) Distinct() Stream[T] {
@@ -261,6 +346,61 @@ func (s Stream[T]) SortedWith(sortFn func([]T)) Stream[T] {
 	}
 }
 
+// SortedStable returns a Stream with elements sorted according to the less function.
+// Unlike Sorted, elements that compare equal keep their original relative order,
+// which makes the output deterministic (e.g. for pagination).
+// This operation materializes the entire stream into memory.
+func (s Stream[T]) SortedStable(less func(T, T) bool) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			slice := s.ToSlice()
+			sort.SliceStable(slice, func(i, j int) bool {
+				return less(slice[i], slice[j])
+			})
+			for _, v := range slice {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// SortedBy returns a Stream with elements sorted in ascending order of the key extracted by keyFn.
+// The sort is stable: elements with equal keys keep their original relative order.
+// Each key is extracted once per element.
+// This operation materializes the entire stream into memory.
+func SortedBy[T any, K cmp.Ordered](s Stream[T], keyFn func(T) K) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			slice := s.ToSlice()
+			keys := make([]K, len(slice))
+			for i, v := range slice {
+				keys[i] = keyFn(v)
+			}
+			sort.Stable(byKey[T, K]{values: slice, keys: keys})
+			for _, v := range slice {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// byKey implements sort.Interface over values paired with precomputed keys.
+type byKey[T any, K cmp.Ordered] struct {
+	values []T
+	keys   []K
+}
+
+func (b byKey[T, K]) Len() int           { return len(b.values) }
+func (b byKey[T, K]) Less(i, j int) bool { return cmp.Less(b.keys[i], b.keys[j]) }
+func (b byKey[T, K]) Swap(i, j int) {
+	b.values[i], b.values[j] = b.values[j], b.values[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
 // Peek performs an action on each element without modifying the stream.
 // Useful for debugging or side effects.
 func (s Stream[T]) Peek(action func(T)) Stream[T] {
@@ -277,18 +417,22 @@ func (s Stream[T]) Peek(action func(T)) Stream[T] {
 }
 
 // Limit returns a Stream with at most n elements.
+// The source is not pulled again once the n-th element has been passed on.
 func (s Stream[T]) Limit(n int64) Stream[T] {
 	return Stream[T]{
 		seq: func(yield func(T) bool) {
+			if n <= 0 {
+				return
+			}
 			count := int64(0)
 			for v := range s.seq {
-				if count >= n {
-					return
-				}
 				if !yield(v) {
 					return
 				}
 				count++
+				if count >= n {
+					return
+				}
 			}
 		},
 	}
@@ -364,6 +508,69 @@ func (s Stream[T]) Concat(other Stream[T]) Stream[T] {
 	}
 }
 
+// ZipWith pairs the elements of two streams positionally and combines each pair with fn.
+// Both streams are consumed lazily and in lockstep; the result ends as soon as either stream ends.
+func ZipWith[A, B, C any](a Stream[A], b Stream[B], fn func(A, B) C) Stream[C] {
+	return Stream[C]{
+		seq: func(yield func(C) bool) {
+			nextB, stop := iter.Pull(b.seq)
+			defer stop()
+			for va := range a.seq {
+				vb, ok := nextB()
+				if !ok || !yield(fn(va, vb)) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Zip pairs the elements of two streams positionally into a Stream2.
+// The result ends as soon as either stream ends. Use ZipWith to combine each pair directly.
+func Zip[A, B any](a Stream[A], b Stream[B]) Stream2[A, B] {
+	return Stream2[A, B]{
+		seq: func(yield func(A, B) bool) {
+			nextB, stop := iter.Pull(b.seq)
+			defer stop()
+			for va := range a.seq {
+				vb, ok := nextB()
+				if !ok || !yield(va, vb) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Interleave combines streams round-robin: the first element of each stream, then the second, and so on.
+// Streams that end are dropped from the rotation; the result ends when every stream has ended.
+func Interleave[T any](streams ...Stream[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			nexts := make([]func() (T, bool), 0, len(streams))
+			for _, s := range streams {
+				next, stop := iter.Pull(s.seq)
+				defer stop()
+				nexts = append(nexts, next)
+			}
+			for len(nexts) > 0 {
+				active := nexts[:0]
+				for _, next := range nexts {
+					v, ok := next()
+					if !ok {
+						continue
+					}
+					if !yield(v) {
+						return
+					}
+					active = append(active, next)
+				}
+				nexts = active
+			}
+		},
+	}
+}
+
 // Reverse returns a Stream with elements in reverse order.
 // This operation materializes the entire stream into memory.
 func (s Stream[T]) Reverse() Stream[T] {
@@ -389,20 +596,32 @@ func (s Stream[T]) ForEach(action func(T)) {
 // Collect gathers stream elements using the provided Collector.
 // Returns the result type R as specified by the collector.
 // The return type is automatically inferred from the collector's type parameters.
+// Like CollectTo, it stops consuming the stream early for short-circuiting collectors.
 func (s Stream[T]) Collect(collector collectors.Collector[T, any, any]) any {
-	acc := collector.Supplier()
-	for v := range s.seq {
-		acc = collector.Accumulator(acc, v)
-	}
-	return collector.Finisher(acc)
+	return CollectTo(s, collector)
 }
 
 // CollectTo gathers stream elements using the provided Collector with full type safety.
 // Use this when you need the exact return type instead of any.
+//
+// If the collector implements collectors.ShortCircuiting, CollectTo stops pulling elements as soon
+// as the collector reports it is done, so collectors like First or AnyMatch work on infinite streams.
 func CollectTo[T, A, R any](s Stream[T], collector collectors.Collector[T, A, R]) R {
 	acc := collector.Supplier()
-	for v := range s.seq {
-		acc = collector.Accumulator(acc, v)
+	sc, ok := collector.(collectors.ShortCircuiting[A])
+	if !ok {
+		for v := range s.seq {
+			acc = collector.Accumulator(acc, v)
+		}
+		return collector.Finisher(acc)
+	}
+	if !sc.Done(acc) {
+		for v := range s.seq {
+			acc = collector.Accumulator(acc, v)
+			if sc.Done(acc) {
+				break
+			}
+		}
 	}
 	return collector.Finisher(acc)
 }
@@ -531,6 +750,8 @@ func (s Stream[T]) Max(less func(T, T) bool) optional.Option[T] {
 }
 
 // ToMap collects elements into a map using key and value functions.
+//
+// Deprecated: Use the ToMap function, which keeps the key and value types.
 func (s Stream[T]) ToMap(keyFn func(T) any, valueFn func(T) any) map[any]any {
 	result := make(map[any]any)
 	for v := range s.seq {
@@ -540,6 +761,8 @@ func (s Stream[T]) ToMap(keyFn func(T) any, valueFn func(T) any) map[any]any {
 }
 
 // ToMapBy collects elements into a map using a key function, with the element as value.
+//
+// Deprecated: Use the ToMapBy function, which keeps the key type.
 func (s Stream[T]) ToMapBy(keyFn func(T) any) map[any]T {
 	result := make(map[any]T)
 	for v := range s.seq {
@@ -549,6 +772,8 @@ func (s Stream[T]) ToMapBy(keyFn func(T) any) map[any]T {
 }
 
 // GroupBy groups elements by a key function.
+//
+// Deprecated: Use the GroupBy function, which keeps the key type.
 func (s Stream[T]) GroupBy(keyFn func(T) any) map[any][]T {
 	result := make(map[any][]T)
 	for v := range s.seq {
@@ -558,6 +783,42 @@ func (s Stream[T]) GroupBy(keyFn func(T) any) map[any][]T {
 	return result
 }
 
+// ToMap collects elements into a map using key and value functions.
+// When several elements have the same key, the last one wins.
+func ToMap[T any, K comparable, V any](s Stream[T], keyFn func(T) K, valueFn func(T) V) map[K]V {
+	result := make(map[K]V)
+	for v := range s.seq {
+		result[keyFn(v)] = valueFn(v)
+	}
+	return result
+}
+
+// ToMapBy collects elements into a map using a key function, with the element as value.
+// When several elements have the same key, the last one wins.
+func ToMapBy[T any, K comparable](s Stream[T], keyFn func(T) K) map[K]T {
+	result := make(map[K]T)
+	for v := range s.seq {
+		result[keyFn(v)] = v
+	}
+	return result
+}
+
+// GroupBy groups elements by a key function, keeping the order of elements within each group.
+func GroupBy[T any, K comparable](s Stream[T], keyFn func(T) K) map[K][]T {
+	result := make(map[K][]T)
+	for v := range s.seq {
+		key := keyFn(v)
+		result[key] = append(result[key], v)
+	}
+	return result
+}
+
+// GroupByTo groups elements by a key function and reduces each group with the downstream collector,
+// in a single pass. It is shorthand for CollectTo with collectors.GroupingByWith.
+func GroupByTo[T any, K comparable, A, R any](s Stream[T], keyFn func(T) K, downstream collectors.Collector[T, A, R]) map[K]R {
+	return CollectTo(s, collectors.GroupingByWith(keyFn, downstream))
+}
+
 // PartitionBy partitions elements into two slices based on a predicate.
 // Returns (matching, notMatching).
 func (s Stream[T]) PartitionBy(predicate func(T) bool) ([]T, []T) {
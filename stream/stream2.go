@@ -0,0 +1,207 @@
+package stream
+
+import (
+	"iter"
+
+	"github.com/marouanesouiri/stdx/cmap"
+	"github.com/marouanesouiri/stdx/mmap"
+	"github.com/marouanesouiri/stdx/omap"
+)
+
+// Stream2 wraps an iter.Seq2 and provides functional operations on sequences of key-value pairs.
+// Like Stream, all intermediate operations are lazy.
+type Stream2[K, V any] struct {
+	seq iter.Seq2[K, V]
+}
+
+// FromSeq2 creates a Stream2 from an iter.Seq2.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) Stream2[K, V] {
+	return Stream2[K, V]{seq: seq}
+}
+
+// FromMap creates a Stream2 from the entries of a map, in unspecified order.
+func FromMap[K comparable, V any](m map[K]V) Stream2[K, V] {
+	return Stream2[K, V]{
+		seq: func(yield func(K, V) bool) {
+			for k, v := range m {
+				if !yield(k, v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// FromOrderedMap creates a Stream2 from the entries of an OrderedMap, in insertion order.
+func FromOrderedMap[K comparable, V any](m *omap.OrderedMap[K, V]) Stream2[K, V] {
+	return Stream2[K, V]{seq: m.Seq()}
+}
+
+// FromConcurrentMap creates a Stream2 from the entries of a ConcurrentMap, in unspecified order.
+// Each shard is read-locked while its entries are consumed, so the pipeline must not write to the map.
+func FromConcurrentMap[K comparable, V any](m *cmap.ConcurrentMap[K, V]) Stream2[K, V] {
+	return Stream2[K, V]{seq: m.Range}
+}
+
+// FromMultimap creates a Stream2 with one pair for every key-value association in a Multimap.
+func FromMultimap[K comparable, V comparable](m *mmap.Multimap[K, V]) Stream2[K, V] {
+	return Stream2[K, V]{seq: m.Range}
+}
+
+// Seq2 returns the underlying iter.Seq2 for use with for-range loops.
+func (s Stream2[K, V]) Seq2() iter.Seq2[K, V] {
+	return s.seq
+}
+
+// Filter returns a Stream2 containing only the pairs matching the predicate.
+func (s Stream2[K, V]) Filter(predicate func(K, V) bool) Stream2[K, V] {
+	return Stream2[K, V]{
+		seq: func(yield func(K, V) bool) {
+			for k, v := range s.seq {
+				if predicate(k, v) && !yield(k, v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// FilterKeys returns a Stream2 containing only the pairs whose key matches the predicate.
+func (s Stream2[K, V]) FilterKeys(predicate func(K) bool) Stream2[K, V] {
+	return s.Filter(func(k K, _ V) bool { return predicate(k) })
+}
+
+// FilterValues returns a Stream2 containing only the pairs whose value matches the predicate.
+func (s Stream2[K, V]) FilterValues(predicate func(V) bool) Stream2[K, V] {
+	return s.Filter(func(_ K, v V) bool { return predicate(v) })
+}
+
+// MapValues returns a Stream2 with each value transformed by the mapper function.
+// Use MapValuesTo to change the value type.
+func (s Stream2[K, V]) MapValues(mapper func(V) V) Stream2[K, V] {
+	return MapValuesTo(s, mapper)
+}
+
+// MapValuesTo returns a Stream2 with each value transformed by the mapper function, which may change its type.
+func MapValuesTo[K, V, U any](s Stream2[K, V], mapper func(V) U) Stream2[K, U] {
+	return Stream2[K, U]{
+		seq: func(yield func(K, U) bool) {
+			for k, v := range s.seq {
+				if !yield(k, mapper(v)) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Limit returns a Stream2 with at most n pairs.
+func (s Stream2[K, V]) Limit(n int64) Stream2[K, V] {
+	return Stream2[K, V]{
+		seq: func(yield func(K, V) bool) {
+			if n <= 0 {
+				return
+			}
+			var count int64
+			for k, v := range s.seq {
+				if !yield(k, v) {
+					return
+				}
+				count++
+				if count >= n {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Keys returns a Stream of the keys.
+func (s Stream2[K, V]) Keys() Stream[K] {
+	return Stream[K]{
+		seq: func(yield func(K) bool) {
+			for k := range s.seq {
+				if !yield(k) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Values returns a Stream of the values.
+func (s Stream2[K, V]) Values() Stream[V] {
+	return Stream[V]{
+		seq: func(yield func(V) bool) {
+			for _, v := range s.seq {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ToStream combines each pair into a single element with fn.
+func ToStream[K, V, T any](s Stream2[K, V], fn func(K, V) T) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for k, v := range s.seq {
+				if !yield(fn(k, v)) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// KeyBy creates a Stream2 pairing each element with the key computed by keyFn.
+func KeyBy[T, K any](s Stream[T], keyFn func(T) K) Stream2[K, T] {
+	return Stream2[K, T]{
+		seq: func(yield func(K, T) bool) {
+			for v := range s.seq {
+				if !yield(keyFn(v), v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ForEach executes an action for each pair.
+func (s Stream2[K, V]) ForEach(action func(K, V)) {
+	for k, v := range s.seq {
+		action(k, v)
+	}
+}
+
+// Count returns the number of pairs.
+func (s Stream2[K, V]) Count() int64 {
+	var count int64
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// CollectMap collects the pairs into a map. When a key occurs more than once, the last value wins.
+func CollectMap[K comparable, V any](s Stream2[K, V]) map[K]V {
+	result := make(map[K]V)
+	for k, v := range s.seq {
+		result[k] = v
+	}
+	return result
+}
+
+// ReduceByKey collects the pairs into a map, combining the values of each key with the reducer function.
+func ReduceByKey[K comparable, V any](s Stream2[K, V], reducer func(V, V) V) map[K]V {
+	result := make(map[K]V)
+	for k, v := range s.seq {
+		if acc, ok := result[k]; ok {
+			result[k] = reducer(acc, v)
+		} else {
+			result[k] = v
+		}
+	}
+	return result
+}
@@ -1,8 +1,21 @@
 package stream
 
 import (
+	"context"
+	"fmt"
+	"iter"
+	"math"
+	"math/rand"
+	"os"
+	"slices"
 	"strconv"
+	"sync"
 	"testing"
+
+	"github.com/marouanesouiri/stdx/collectors"
+	"github.com/marouanesouiri/stdx/mmap"
+	"github.com/marouanesouiri/stdx/omap"
+	"github.com/marouanesouiri/stdx/set"
 )
 
 func TestFrom(t *testing.T) {
@@ -84,6 +97,60 @@ func TestSorted(t *testing.T) {
 	}
 }
 
+func TestSortedStable(t *testing.T) {
+	type item struct {
+		key   int
+		label string
+	}
+	s := From([]item{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	result := s.SortedStable(func(a, b item) bool { return a.key < b.key }).ToSlice()
+	expected := []string{"b", "d", "a", "c"}
+	for i, v := range result {
+		if v.label != expected[i] {
+			t.Errorf("expected %s at %d, got %s", expected[i], i, v.label)
+		}
+	}
+}
+
+func TestSortedBy(t *testing.T) {
+	words := From([]string{"ccc", "a", "bb", "dd", "e"})
+	result := SortedBy(words, func(s string) int { return len(s) }).ToSlice()
+	expected := []string{"a", "e", "bb", "dd", "ccc"}
+	for i, v := range result {
+		if v != expected[i] {
+			t.Errorf("expected %s at %d, got %s", expected[i], i, v)
+		}
+	}
+}
+
+func TestSortedExternal(t *testing.T) {
+	dir := t.TempDir()
+	result := Iterate(7, func(x int) int { return (x * 31) % 101 }).
+		Limit(100).
+		SortedExternal(func(a, b int) bool { return a < b }, dir, 8).
+		ToSlice()
+	if len(result) != 100 {
+		t.Fatalf("expected 100 elements, got %d", len(result))
+	}
+	for i := 1; i < len(result); i++ {
+		if result[i-1] > result[i] {
+			t.Fatalf("expected sorted output, got %d before %d", result[i-1], result[i])
+		}
+	}
+
+	first := From([]int{5, 3, 9, 1, 7}).
+		SortedExternal(func(a, b int) bool { return a < b }, dir, 2).
+		FindFirst()
+	if first.Get() != 1 {
+		t.Errorf("expected 1, got %v", first)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("expected temporary files to be removed, found %d", len(entries))
+	}
+}
+
 func TestLimit(t *testing.T) {
 	s := From([]int{1, 2, 3, 4, 5})
 	result := s.Limit(3).ToSlice()
@@ -131,6 +198,527 @@ func TestConcat(t *testing.T) {
 	}
 }
 
+func TestZipWith(t *testing.T) {
+	names := Of("a", "b", "c")
+	numbers := Iterate(1, func(n int) int { return n + 1 })
+	result := ZipWith(names, numbers, func(s string, n int) string {
+		return s + strconv.Itoa(n)
+	}).ToSlice()
+	expected := []string{"a1", "b2", "c3"}
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d elements, got %d", len(expected), len(result))
+	}
+	for i, v := range result {
+		if v != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], v)
+		}
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	result := ParallelMap(Range(0, 100), 4, func(x int) int { return x * x }).ToSlice()
+	if len(result) != 100 {
+		t.Fatalf("expected 100 elements, got %d", len(result))
+	}
+	for i, v := range result {
+		if v != i*i {
+			t.Fatalf("expected %d at index %d, got %d", i*i, i, v)
+		}
+	}
+
+	first := ParallelMap(Iterate(0, func(x int) int { return x + 1 }), 4, func(x int) int { return x * 2 }).
+		Limit(5).
+		ToSlice()
+	if len(first) != 5 || first[4] != 8 {
+		t.Errorf("expected [0 2 4 6 8], got %v", first)
+	}
+}
+
+func TestParallelMapUnordered(t *testing.T) {
+	sum := ParallelMapUnordered(Range(1, 101), 4, func(x int) int { return x * 2 }).
+		Reduce(0, func(a, b int) int { return a + b })
+	if sum != 10100 {
+		t.Errorf("expected 10100, got %d", sum)
+	}
+
+	count := ParallelMapUnordered(Iterate(0, func(x int) int { return x + 1 }), 4, func(x int) int { return x }).
+		Limit(10).
+		Count()
+	if count != 10 {
+		t.Errorf("expected 10 elements, got %d", count)
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	Range(0, 50).ParallelForEach(4, func(x int) {
+		mu.Lock()
+		seen[x] = true
+		mu.Unlock()
+	})
+	if len(seen) != 50 {
+		t.Errorf("expected 50 elements, got %d", len(seen))
+	}
+}
+
+func TestParallelCollectTo(t *testing.T) {
+	square := func(x int) int { return x * x }
+	sum := ParallelCollectTo(Range(0, 1000), 4, collectors.Mapping(square, collectors.Summing(func(x int) int { return x })))
+	if expected := CollectTo(Range(0, 1000), collectors.Summing(square)); sum != expected {
+		t.Errorf("expected sum %d, got %d", expected, sum)
+	}
+
+	groups := ParallelCollectTo(Range(0, 1000), 4, collectors.GroupingByWith(func(x int) int { return x % 3 }, collectors.Counting[int]()))
+	if groups[0] != 334 || groups[1] != 333 || groups[2] != 333 {
+		t.Errorf("expected counts per remainder, got %v", groups)
+	}
+
+	values := ParallelCollectTo(Range(0, 100), 0, collectors.ToSlice[int]())
+	slices.Sort(values)
+	if len(values) != 100 || values[0] != 0 || values[99] != 99 {
+		t.Errorf("expected all 100 elements, got %d", len(values))
+	}
+
+	// Percentiles cannot be combined, so it is collected sequentially.
+	median := ParallelCollectTo(Range(1, 4), 4, collectors.Percentiles(func(x int) float64 { return float64(x) }, 0.5))
+	if median[0] != 2 {
+		t.Errorf("expected median 2, got %v", median[0])
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	err := Generate(func() int { return 1 }).ForEachCtx(ctx, func(int) {
+		count++
+		if count == 5 {
+			cancel()
+		}
+	})
+	if err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if count != 5 {
+		t.Errorf("expected 5 elements, got %d", count)
+	}
+
+	result, err := Iterate(0, func(x int) int { return x + 1 }).WithContext(ctx).ToSliceCtx(context.Background())
+	if err != nil || len(result) != 0 {
+		t.Errorf("expected empty result for cancelled context, got %v, %v", result, err)
+	}
+}
+
+func TestFromChannelCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+	}()
+	result := FromChannelCtx(ctx, ch).ToSlice()
+	if len(result) != 2 {
+		t.Errorf("expected 2 elements, got %v", result)
+	}
+}
+
+func TestGenerateSeeded(t *testing.T) {
+	roll := func(r *rand.Rand) int { return r.Intn(6) + 1 }
+	s := GenerateSeeded(42, roll).Limit(20)
+	first := s.ToSlice()
+	second := s.ToSlice()
+	if fmt.Sprint(first) != fmt.Sprint(second) {
+		t.Errorf("expected the same sequence on every run, got %v and %v", first, second)
+	}
+	other := GenerateSeeded(43, roll).Limit(20).ToSlice()
+	if fmt.Sprint(first) == fmt.Sprint(other) {
+		t.Errorf("expected different seeds to give different sequences, got %v", other)
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	pages := map[string][]int{"": {1, 2}, "p2": {3}, "p3": {4, 5}}
+	cursors := map[string]string{"": "p2", "p2": "p3"}
+	fetched := Unfold("", func(cursor string) ([]int, string, bool) {
+		if cursor == "done" {
+			return nil, "", false
+		}
+		next, ok := cursors[cursor]
+		if !ok {
+			next = "done"
+		}
+		return pages[cursor], next, true
+	})
+	result := FlatMapTo(fetched, func(page []int) Stream[int] { return From(page) }).ToSlice()
+	if fmt.Sprint(result) != "[1 2 3 4 5]" {
+		t.Errorf("expected [1 2 3 4 5], got %v", result)
+	}
+
+	n := 0
+	countdown := FromFunc(func() (int, bool) {
+		n++
+		return n, n <= 3
+	}).ToSlice()
+	if fmt.Sprint(countdown) != "[1 2 3]" {
+		t.Errorf("expected [1 2 3], got %v", countdown)
+	}
+}
+
+func TestSample(t *testing.T) {
+	n := Range(0, 10000).Sample(0.1, rand.New(rand.NewSource(1))).Count()
+	if n < 800 || n > 1200 {
+		t.Errorf("expected about 1000 elements, got %d", n)
+	}
+	first := Range(0, 100).Sample(0.5, rand.New(rand.NewSource(2))).ToSlice()
+	second := Range(0, 100).Sample(0.5, rand.New(rand.NewSource(2))).ToSlice()
+	if fmt.Sprint(first) != fmt.Sprint(second) {
+		t.Error("expected the same seed to give the same sample")
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	result := Range(0, 50).Shuffle(rand.New(rand.NewSource(3))).ToSlice()
+	sorted := From(result).Sorted(func(a, b int) bool { return a < b }).ToSlice()
+	if fmt.Sprint(sorted) != fmt.Sprint(Range(0, 50).ToSlice()) {
+		t.Errorf("expected a permutation of 0..49, got %v", result)
+	}
+	if fmt.Sprint(result) == fmt.Sprint(sorted) {
+		t.Error("expected the elements to be reordered")
+	}
+}
+
+func TestReservoirSample(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	if all := Of(1, 2).ReservoirSample(5, rng); len(all) != 2 {
+		t.Errorf("expected every element of a short stream, got %v", all)
+	}
+
+	counts := make([]int, 10)
+	for range 10000 {
+		for _, v := range Range(0, 10).ReservoirSample(3, rng) {
+			counts[v]++
+		}
+	}
+	for v, c := range counts {
+		if c < 2700 || c > 3300 {
+			t.Errorf("expected element %d to be picked about 3000 times, got %d", v, c)
+		}
+	}
+}
+
+func TestChunk(t *testing.T) {
+	result := Chunk(Range(0, 7), 3).ToSlice()
+	if fmt.Sprint(result) != "[[0 1 2] [3 4 5] [6]]" {
+		t.Errorf("expected [[0 1 2] [3 4 5] [6]], got %v", result)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		size, step int
+		expected   string
+	}{
+		{3, 1, "[[0 1 2] [1 2 3] [2 3 4] [3 4 5]]"},
+		{2, 2, "[[0 1] [2 3] [4 5]]"},
+		{2, 3, "[[0 1] [3 4]]"},
+	}
+	for _, tt := range tests {
+		result := Window(Range(0, 6), tt.size, tt.step).ToSlice()
+		if fmt.Sprint(result) != tt.expected {
+			t.Errorf("Window(%d, %d): expected %s, got %v", tt.size, tt.step, tt.expected, result)
+		}
+	}
+}
+
+func TestGroupByStreaming(t *testing.T) {
+	var groups []string
+	GroupByStreaming(Of(1, 3, 2, 4, 6, 5, 7), func(x int) bool { return x%2 == 0 }).
+		ForEach(func(even bool, s Stream[int]) {
+			groups = append(groups, fmt.Sprint(even, s.ToSlice()))
+		})
+	if fmt.Sprint(groups) != "[false [1 3] true [2 4 6] false [5 7]]" {
+		t.Errorf("expected groups of consecutive keys, got %v", groups)
+	}
+
+	// Unconsumed elements are skipped, and a group is empty once the next one was requested.
+	var firsts []int
+	var kept []Stream[int]
+	GroupByStreaming(Range(0, 9), func(x int) int { return x / 3 }).
+		ForEach(func(_ int, s Stream[int]) {
+			firsts = append(firsts, s.FindFirst().Get())
+			kept = append(kept, s)
+		})
+	if fmt.Sprint(firsts) != "[0 3 6]" {
+		t.Errorf("expected [0 3 6], got %v", firsts)
+	}
+	if rest := kept[0].ToSlice(); len(rest) != 0 {
+		t.Errorf("expected a stale group to be empty, got %v", rest)
+	}
+
+	// A stale group consumed mid-iteration must not steal a later run with the same key.
+	var stale Stream[int]
+	var got []string
+	GroupByStreaming(Of(1, 2, 3, 5), func(x int) int { return x % 2 }).
+		ForEach(func(key int, s Stream[int]) {
+			switch {
+			case stale.seq == nil:
+				stale = s
+			case key == 1:
+				got = append(got, fmt.Sprint(stale.ToSlice(), s.ToSlice()))
+			}
+		})
+	if fmt.Sprint(got) != "[[] [3 5]]" {
+		t.Errorf("expected stale group to be empty and the current one intact, got %v", got)
+	}
+}
+
+func TestBuffer(t *testing.T) {
+	result := Range(0, 100).Buffer(10).ToSlice()
+	if len(result) != 100 || result[99] != 99 {
+		t.Errorf("expected 100 elements in order, got %v", result)
+	}
+
+	first := Iterate(0, func(x int) int { return x + 1 }).Buffer(4).Limit(3).ToSlice()
+	if fmt.Sprint(first) != "[0 1 2]" {
+		t.Errorf("expected [0 1 2], got %v", first)
+	}
+}
+
+func TestStream2(t *testing.T) {
+	stock := map[string]int{"apple": 3, "banana": 0, "cherry": 7}
+	result := CollectMap(FromMap(stock).
+		FilterValues(func(n int) bool { return n > 0 }).
+		MapValues(func(n int) int { return n * 10 }))
+	if len(result) != 2 || result["apple"] != 30 || result["cherry"] != 70 {
+		t.Errorf("expected map[apple:30 cherry:70], got %v", result)
+	}
+
+	om := omap.New[string, int]()
+	om.Set("b", 2)
+	om.Set("a", 1)
+	keys := FromOrderedMap(&om).Keys().ToSlice()
+	if fmt.Sprint(keys) != "[b a]" {
+		t.Errorf("expected [b a], got %v", keys)
+	}
+}
+
+func TestReduceByKey(t *testing.T) {
+	words := From([]string{"go", "rust", "go", "zig", "go"})
+	counts := ReduceByKey(
+		MapValuesTo(KeyBy(words, func(w string) string { return w }), func(string) int { return 1 }),
+		func(a, b int) int { return a + b },
+	)
+	if counts["go"] != 3 || counts["rust"] != 1 || counts["zig"] != 1 {
+		t.Errorf("expected map[go:3 rust:1 zig:1], got %v", counts)
+	}
+}
+
+func TestTryMapTo(t *testing.T) {
+	values, err := CollectOrError(TryMapTo(From([]string{"1", "2", "3"}), strconv.Atoi))
+	if err != nil || fmt.Sprint(values) != "[1 2 3]" {
+		t.Errorf("expected [1 2 3], got %v, %v", values, err)
+	}
+
+	consumed := 0
+	source := From([]string{"1", "x", "3"}).Peek(func(string) { consumed++ })
+	values, err = CollectOrError(TryMapTo(source, strconv.Atoi))
+	if err == nil || values != nil {
+		t.Errorf("expected an error and no values, got %v, %v", values, err)
+	}
+	if consumed != 2 {
+		t.Errorf("expected the stream to stop at the error, consumed %d elements", consumed)
+	}
+}
+
+func TestZip(t *testing.T) {
+	pairs := CollectMap(Zip(Of("a", "b", "c"), Range(1, 10)))
+	if len(pairs) != 3 || pairs["a"] != 1 || pairs["c"] != 3 {
+		t.Errorf("expected map[a:1 b:2 c:3], got %v", pairs)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	result := Interleave(Of(1, 4, 7, 9), Of(2, 5), Of(3, 6, 8)).ToSlice()
+	if fmt.Sprint(result) != "[1 2 3 4 5 6 7 8 9]" {
+		t.Errorf("expected [1 2 3 4 5 6 7 8 9], got %v", result)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int)
+	go func() {
+		for i := 0; i < 50; i++ {
+			a <- i
+		}
+		close(a)
+	}()
+	go func() {
+		for i := 50; i < 100; i++ {
+			b <- i
+		}
+		close(b)
+	}()
+
+	result := Merge(FromChannel(a), FromChannel(b)).ToSlice()
+	if len(result) != 100 {
+		t.Fatalf("expected 100 elements, got %d", len(result))
+	}
+	lastA, lastB := -1, 49
+	for _, v := range result {
+		if v < 50 {
+			if v < lastA {
+				t.Errorf("expected order within a stream to be kept, got %d after %d", v, lastA)
+			}
+			lastA = v
+		} else {
+			if v < lastB {
+				t.Errorf("expected order within a stream to be kept, got %d after %d", v, lastB)
+			}
+			lastB = v
+		}
+	}
+
+	first := Merge(Generate(func() int { return 1 }), Generate(func() int { return 2 })).Limit(10).Count()
+	if first != 10 {
+		t.Errorf("expected 10 elements, got %d", first)
+	}
+}
+
+func TestNumericTerminals(t *testing.T) {
+	if sum := SumOf(Range(1, 101)); sum != 5050 {
+		t.Errorf("expected 5050, got %d", sum)
+	}
+	if avg := AverageOf(Of(1, 2, 3, 4)); avg.Get() != 2.5 {
+		t.Errorf("expected 2.5, got %v", avg)
+	}
+	if avg := AverageOf(Empty[int]()); avg.IsPresent() {
+		t.Errorf("expected None for empty stream, got %v", avg)
+	}
+	if mm := MinMaxOf(Of(3, -1, 7, 2)).Get(); mm.Min != -1 || mm.Max != 7 {
+		t.Errorf("expected min -1 and max 7, got %+v", mm)
+	}
+
+	big := Of[int64](math.MaxInt64, math.MaxInt64, -1)
+	if sum := SumOfBig(big); sum.String() != "18446744073709551613" {
+		t.Errorf("expected 18446744073709551613, got %s", sum)
+	}
+	if sum := SumOfBig(Of[uint64](math.MaxUint64, 1)); sum.String() != "18446744073709551616" {
+		t.Errorf("expected 18446744073709551616, got %s", sum)
+	}
+}
+
+func TestTopN(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	data := GenerateSeeded(7, func(r *rand.Rand) int { return r.Intn(1000) }).Limit(500).ToSlice()
+
+	top := From(data).TopN(5, less).ToSlice()
+	if expected := From(data).Sorted(less).Reverse().Limit(5).ToSlice(); fmt.Sprint(top) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, top)
+	}
+	bottom := From(data).BottomN(5, less).ToSlice()
+	if expected := From(data).Sorted(less).Limit(5).ToSlice(); fmt.Sprint(bottom) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, bottom)
+	}
+	if all := Of(2, 1).TopN(10, less).ToSlice(); fmt.Sprint(all) != "[2 1]" {
+		t.Errorf("expected [2 1], got %v", all)
+	}
+}
+
+func TestContainerSources(t *testing.T) {
+	tags := set.FromSlice([]string{"go", "db"})
+	if n := FromSet(tags).Count(); n != 2 {
+		t.Errorf("expected 2 elements, got %d", n)
+	}
+
+	om := omap.New[string, int]()
+	om.Set("x", 1)
+	om.Set("y", 2)
+	if values := FromOmapValues(&om).ToSlice(); fmt.Sprint(values) != "[1 2]" {
+		t.Errorf("expected [1 2], got %v", values)
+	}
+	if items := FromOmapItems(&om).ToSlice(); fmt.Sprint(items) != "[{x 1} {y 2}]" {
+		t.Errorf("expected [{x 1} {y 2}], got %v", items)
+	}
+
+	mm := mmap.New[string, int]()
+	mm.PutAll("a", 1, 2)
+	if n := FromMultimapEntries(&mm).Count(); n != 2 {
+		t.Errorf("expected 2 entries, got %d", n)
+	}
+}
+
+func TestFlatMapSeq(t *testing.T) {
+	groups := [][]int{{1, 2}, {}, {3}}
+	fromSeq := FlatMapSeq(From(groups), func(g []int) iter.Seq[int] { return slices.Values(g) }).ToSlice()
+	fromSlice := FlatMapSlice(From(groups), func(g []int) []int { return g }).ToSlice()
+	if fmt.Sprint(fromSeq) != "[1 2 3]" || fmt.Sprint(fromSlice) != "[1 2 3]" {
+		t.Errorf("expected [1 2 3], got %v and %v", fromSeq, fromSlice)
+	}
+}
+
+// counting returns an infinite stream of 0, 1, 2, ... and a pointer to the number of elements pulled from it.
+func counting() (Stream[int], *int) {
+	pulled := new(int)
+	return Generate(func() int {
+		*pulled++
+		return *pulled - 1
+	}), pulled
+}
+
+func TestShortCircuit(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(s Stream[int])
+		expected int
+	}{
+		{"Limit", func(s Stream[int]) { s.Limit(3).ToSlice() }, 3},
+		{"Limit(0)", func(s Stream[int]) { s.Limit(0).ToSlice() }, 0},
+		{"FindFirst", func(s Stream[int]) { s.Filter(func(x int) bool { return x > 4 }).FindFirst() }, 6},
+		{"AnyMatch", func(s Stream[int]) { s.AnyMatch(func(x int) bool { return x == 2 }) }, 3},
+		{"AllMatch", func(s Stream[int]) { s.AllMatch(func(x int) bool { return x < 2 }) }, 3},
+		{"NoneMatch", func(s Stream[int]) { s.NoneMatch(func(x int) bool { return x == 1 }) }, 2},
+		{"TakeWhile", func(s Stream[int]) { s.TakeWhile(func(x int) bool { return x < 2 }).ToSlice() }, 3},
+		{"Concat", func(s Stream[int]) { Of(-2, -1).Concat(s).Limit(4).ToSlice() }, 2},
+		{"ConcatFirst", func(s Stream[int]) { s.Concat(Of(-1)).Limit(2).ToSlice() }, 2},
+		{"FlatMap", func(s Stream[int]) {
+			s.FlatMap(func(x int) Stream[int] { return Of(x, x) }).Limit(3).ToSlice()
+		}, 2},
+		{"FlatMapInner", func(s Stream[int]) {
+			Of(1, 2).FlatMap(func(int) Stream[int] { return s }).FindFirst()
+		}, 1},
+		{"Chunk", func(s Stream[int]) { Chunk(s, 2).Limit(2).ToSlice() }, 4},
+		{"GroupByStreaming", func(s Stream[int]) {
+			GroupByStreaming(s, func(x int) int { return x / 3 }).Limit(2).Count()
+		}, 4},
+		{"ZipWith", func(s Stream[int]) {
+			ZipWith(Of(1, 2), s, func(a, b int) int { return a + b }).ToSlice()
+		}, 2},
+		{"Peek", func(s Stream[int]) { s.Peek(func(int) {}).Skip(1).Limit(2).ToSlice() }, 3},
+		{"CollectFirst", func(s Stream[int]) { CollectTo(s, collectors.First[int]()) }, 1},
+		{"CollectAnyMatch", func(s Stream[int]) {
+			CollectTo(s, collectors.AnyMatch(func(x int) bool { return x == 4 }))
+		}, 5},
+		{"CollectAllMatch", func(s Stream[int]) {
+			s.Collect(collectors.Erase(collectors.AllMatch(func(x int) bool { return x < 2 })))
+		}, 3},
+		{"CollectTee2", func(s Stream[int]) {
+			CollectTo(s, collectors.Tee2(
+				collectors.First[int](),
+				collectors.Filtering(func(x int) bool { return x > 2 }, collectors.First[int]()),
+			))
+		}, 4},
+	}
+	for _, tt := range tests {
+		s, pulled := counting()
+		tt.run(s)
+		if *pulled != tt.expected {
+			t.Errorf("%s: expected %d elements pulled, got %d", tt.name, tt.expected, *pulled)
+		}
+	}
+}
+
 func TestReverse(t *testing.T) {
 	s := From([]int{1, 2, 3, 4, 5})
 	result := s.Reverse().ToSlice()
@@ -240,6 +828,31 @@ func TestGroupBy(t *testing.T) {
 	}
 }
 
+func TestTypedToMapAndGroupBy(t *testing.T) {
+	words := From([]string{"go", "java", "c", "rust"})
+	lengths := ToMap(words, func(w string) string { return w }, func(w string) int { return len(w) })
+	if lengths["java"] != 4 || len(lengths) != 4 {
+		t.Errorf("expected 4 entries with java=4, got %v", lengths)
+	}
+
+	byLen := ToMapBy(words, func(w string) int { return len(w) })
+	if byLen[2] != "go" {
+		t.Errorf("expected go for length 2, got %v", byLen)
+	}
+
+	groups := GroupBy(words, func(w string) bool { return len(w) > 2 })
+	if fmt.Sprint(groups[true]) != "[java rust]" || fmt.Sprint(groups[false]) != "[go c]" {
+		t.Errorf("expected map[false:[go c] true:[java rust]], got %v", groups)
+	}
+}
+
+func TestGroupByTo(t *testing.T) {
+	counts := GroupByTo(Range(0, 10), func(x int) bool { return x%2 == 0 }, collectors.Counting[int]())
+	if counts[true] != 5 || counts[false] != 5 {
+		t.Errorf("expected 5 even and 5 odd, got %v", counts)
+	}
+}
+
 func TestPartitionBy(t *testing.T) {
 	s := From([]int{1, 2, 3, 4, 5, 6})
 	evens, odds := s.PartitionBy(func(x int) bool { return x%2 == 0 })
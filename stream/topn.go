@@ -0,0 +1,59 @@
+package stream
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// BottomN returns a Stream of the n smallest elements according to less, in ascending order.
+// It gives the same elements as Sorted(less).Limit(n), but keeps only n elements in a bounded heap,
+// so selecting a few elements from a large stream takes O(len log n) time and O(n) memory.
+// The order of equal elements is unspecified.
+func (s Stream[T]) BottomN(n int, less func(T, T) bool) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			if n <= 0 {
+				return
+			}
+			// The heap root is the largest element kept, the first to be evicted.
+			h := &boundedHeap[T]{less: func(a, b T) bool { return less(b, a) }}
+			for v := range s.seq {
+				if len(h.values) < n {
+					heap.Push(h, v)
+				} else if less(v, h.values[0]) {
+					h.values[0] = v
+					heap.Fix(h, 0)
+				}
+			}
+			sort.Slice(h.values, func(i, j int) bool { return less(h.values[i], h.values[j]) })
+			for _, v := range h.values {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// TopN returns a Stream of the n largest elements according to less, in descending order.
+// See BottomN for complexity.
+func (s Stream[T]) TopN(n int, less func(T, T) bool) Stream[T] {
+	return s.BottomN(n, func(a, b T) bool { return less(b, a) })
+}
+
+// boundedHeap implements heap.Interface over a slice of values.
+type boundedHeap[T any] struct {
+	values []T
+	less   func(T, T) bool
+}
+
+func (h *boundedHeap[T]) Len() int           { return len(h.values) }
+func (h *boundedHeap[T]) Less(i, j int) bool { return h.less(h.values[i], h.values[j]) }
+func (h *boundedHeap[T]) Swap(i, j int)      { h.values[i], h.values[j] = h.values[j], h.values[i] }
+func (h *boundedHeap[T]) Push(x any)         { h.values = append(h.values, x.(T)) }
+func (h *boundedHeap[T]) Pop() any {
+	n := len(h.values)
+	v := h.values[n-1]
+	h.values = h.values[:n-1]
+	return v
+}
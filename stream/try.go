@@ -0,0 +1,43 @@
+package stream
+
+import "github.com/marouanesouiri/stdx/result"
+
+// TryMapTo transforms each element with a function that may fail, wrapping each outcome in a result.Result.
+// Errors flow down the stream as elements, so later stages decide whether to stop, skip or recover,
+// for example with CollectOrError.
+func TryMapTo[T, U any](s Stream[T], mapper func(T) (U, error)) Stream[result.Result[U]] {
+	return Stream[result.Result[U]]{
+		seq: func(yield func(result.Result[U]) bool) {
+			for v := range s.seq {
+				if !yield(result.From(mapper(v))) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// CollectOrError collects the values of a stream of results into a slice.
+// It stops at the first Err, without consuming the rest of the stream, and returns that error.
+func CollectOrError[T any](s Stream[result.Result[T]]) ([]T, error) {
+	values := make([]T, 0)
+	for r := range s.seq {
+		if r.IsErr() {
+			return nil, r.Err()
+		}
+		values = append(values, r.Value())
+	}
+	return values, nil
+}
+
+// ForEachOrError executes an action for the value of each result until the first Err,
+// which stops the stream and is returned.
+func ForEachOrError[T any](s Stream[result.Result[T]], action func(T)) error {
+	for r := range s.seq {
+		if r.IsErr() {
+			return r.Err()
+		}
+		action(r.Value())
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package stream
+
+import "iter"
+
+// Chunk groups consecutive elements into slices of n elements.
+// The last chunk holds the remaining elements and may be shorter than n.
+// Each chunk is a new slice that the consumer may keep. Chunk panics if n is not positive.
+func Chunk[T any](s Stream[T], n int) Stream[[]T] {
+	if n <= 0 {
+		panic("stream: Chunk size must be positive")
+	}
+	return Stream[[]T]{
+		seq: func(yield func([]T) bool) {
+			chunk := make([]T, 0, n)
+			for v := range s.seq {
+				chunk = append(chunk, v)
+				if len(chunk) == n {
+					if !yield(chunk) {
+						return
+					}
+					chunk = make([]T, 0, n)
+				}
+			}
+			if len(chunk) > 0 {
+				yield(chunk)
+			}
+		},
+	}
+}
+
+// Window returns sliding windows of size elements, starting a new window every step elements.
+// With step < size windows overlap, with step == size it behaves like Chunk, and with
+// step > size elements between windows are skipped.
+// Only full windows are yielded; trailing elements that cannot fill a window are dropped.
+// Each window is a new slice that the consumer may keep. Window panics if size or step is not positive.
+func Window[T any](s Stream[T], size, step int) Stream[[]T] {
+	if size <= 0 || step <= 0 {
+		panic("stream: Window size and step must be positive")
+	}
+	return Stream[[]T]{
+		seq: func(yield func([]T) bool) {
+			window := make([]T, 0, size)
+			skip := 0
+			for v := range s.seq {
+				if skip > 0 {
+					skip--
+					continue
+				}
+				window = append(window, v)
+				if len(window) < size {
+					continue
+				}
+				if !yield(window) {
+					return
+				}
+				next := make([]T, 0, size)
+				if step < size {
+					next = append(next, window[step:]...)
+				} else {
+					skip = step - size
+				}
+				window = next
+			}
+		},
+	}
+}
+
+// GroupByStreaming groups runs of consecutive elements with the same key, yielding each key
+// with a Stream of its elements as soon as the run starts. Unlike GroupBy, no group is held in
+// memory, so huge inputs that are already clustered by key, such as log files sorted by request ID,
+// can be processed in constant memory. A key that appears in several runs yields several groups.
+//
+// Each group Stream can be consumed at most once and only until the next group is requested;
+// elements of a group that are not consumed are skipped.
+func GroupByStreaming[T any, K comparable](s Stream[T], keyFn func(T) K) Stream2[K, Stream[T]] {
+	return Stream2[K, Stream[T]]{
+		seq: func(yield func(K, Stream[T]) bool) {
+			next, stop := iter.Pull(s.seq)
+			defer stop()
+
+			// v and vKey hold the next unconsumed element, shared by the outer loop and the groups.
+			v, ok := next()
+			var vKey K
+			if ok {
+				vKey = keyFn(v)
+			}
+			advance := func() {
+				if v, ok = next(); ok {
+					vKey = keyFn(v)
+				}
+			}
+
+			// generation identifies the current group; stale groups compare against it and yield nothing.
+			generation := 0
+			for ok {
+				generation++
+				key, id := vKey, generation
+				group := Stream[T]{
+					seq: func(yieldElem func(T) bool) {
+						for id == generation && ok && vKey == key {
+							elem := v
+							advance()
+							if !yieldElem(elem) {
+								return
+							}
+						}
+					},
+				}
+				if !yield(key, group) {
+					return
+				}
+				for ok && vKey == key {
+					advance()
+				}
+			}
+		},
+	}
+}
+
+// Buffer returns a Stream that reads ahead of the consumer, keeping up to n elements ready.
+// The source is consumed from a separate goroutine, so a slow producer and a slow consumer
+// (for example an API call feeding a database write) run concurrently instead of in turn.
+// If the consumer stops early, the stream waits for the element being produced before returning.
+// If n is not positive, the producer runs at most one element ahead.
+func (s Stream[T]) Buffer(n int) Stream[T] {
+	if n < 0 {
+		n = 0
+	}
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			out := make(chan T, n)
+			done := make(chan struct{})
+			finished := make(chan struct{})
+
+			go func() {
+				defer close(finished)
+				defer close(out)
+				for v := range s.seq {
+					select {
+					case out <- v:
+					case <-done:
+						return
+					}
+				}
+			}()
+			defer func() {
+				close(done)
+				<-finished
+			}()
+
+			for v := range out {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Package tree provides a generic n-ary tree for hierarchical data such as
+org charts, file trees or menus.
+
+Every Node holds a value, a link to its parent and an ordered list of children.
+Nodes are built from a root and traversed with Go 1.23 iterators.
+
+Example usage:
+
+	root := tree.New("/")
+	usr := root.AddChild("usr")
+	usr.AddChild("bin")
+	usr.AddChild("lib")
+	root.AddChild("etc")
+
+	for node := range root.PreOrder() {
+		fmt.Println(strings.Repeat("  ", node.Depth()) + node.Value)
+	}
+
+# Traversals
+
+	root.PreOrder()     // depth-first, parents before children
+	root.PostOrder()    // depth-first, children before parents
+	root.BreadthFirst() // level by level
+	root.Values()       // values in pre-order
+
+All traversals are lazy and stop as soon as the loop breaks.
+
+# Searching and Flattening
+
+	bin := root.Find(func(name string) bool { return name == "bin" }) // Option[*Node[string]]
+	leaves := root.Filter(func(name string) bool { return len(name) == 3 })
+	names := root.Flatten() // ["/", "usr", "bin", "lib", "etc"]
+
+Map builds a tree of the same shape with transformed values:
+
+	lengths := tree.Map(root, func(name string) int { return len(name) })
+
+# Restructuring
+
+AppendChild moves an existing node, with its whole subtree, under a new parent.
+RemoveChild and Detach cut a subtree loose, making it a tree of its own.
+
+# Thread Safety
+
+Node is not thread-safe. For concurrent access, use external synchronization.
+*/
+package tree
@@ -0,0 +1,223 @@
+package tree
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// Node is a node of an n-ary tree holding a value of type T.
+// A Node without a parent is the root of its tree.
+type Node[T any] struct {
+	Value    T
+	parent   *Node[T]
+	children []*Node[T]
+}
+
+// New creates a root node holding the given value.
+func New[T any](value T) *Node[T] {
+	return &Node[T]{Value: value}
+}
+
+// Parent returns the parent of the node, or nil if the node is a root.
+func (n *Node[T]) Parent() *Node[T] {
+	return n.parent
+}
+
+// Children returns the direct children of the node, in insertion order.
+// The returned slice is a copy and may be modified freely.
+func (n *Node[T]) Children() []*Node[T] {
+	return slices.Clone(n.children)
+}
+
+// IsRoot returns true if the node has no parent.
+func (n *Node[T]) IsRoot() bool {
+	return n.parent == nil
+}
+
+// IsLeaf returns true if the node has no children.
+func (n *Node[T]) IsLeaf() bool {
+	return len(n.children) == 0
+}
+
+// Root returns the root of the tree the node belongs to.
+func (n *Node[T]) Root() *Node[T] {
+	for n.parent != nil {
+		n = n.parent
+	}
+	return n
+}
+
+// Depth returns the number of edges between the node and the root. The root has depth 0.
+func (n *Node[T]) Depth() int {
+	depth := 0
+	for p := n.parent; p != nil; p = p.parent {
+		depth++
+	}
+	return depth
+}
+
+// Size returns the number of nodes in the subtree rooted at this node, including itself.
+func (n *Node[T]) Size() int {
+	size := 0
+	for range n.PreOrder() {
+		size++
+	}
+	return size
+}
+
+// Path returns the nodes from the root down to this node, inclusive.
+func (n *Node[T]) Path() []*Node[T] {
+	path := make([]*Node[T], 0, n.Depth()+1)
+	for p := n; p != nil; p = p.parent {
+		path = append(path, p)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// AddChild creates a new child holding value, appends it to the node and returns it.
+func (n *Node[T]) AddChild(value T) *Node[T] {
+	child := &Node[T]{Value: value, parent: n}
+	n.children = append(n.children, child)
+	return child
+}
+
+// AppendChild appends an existing node as the last child of this node.
+// If child already has a parent, it is detached from it first.
+// Panics if child is this node or one of its ancestors, since that would create a cycle.
+func (n *Node[T]) AppendChild(child *Node[T]) {
+	for p := n; p != nil; p = p.parent {
+		if p == child {
+			panic("tree: cannot append a node to its own subtree")
+		}
+	}
+	child.Detach()
+	child.parent = n
+	n.children = append(n.children, child)
+}
+
+// RemoveChild removes a direct child of the node.
+// Returns true if child was a child of this node, false otherwise.
+func (n *Node[T]) RemoveChild(child *Node[T]) bool {
+	i := slices.Index(n.children, child)
+	if i < 0 {
+		return false
+	}
+	n.children = slices.Delete(n.children, i, i+1)
+	child.parent = nil
+	return true
+}
+
+// Detach removes the node from its parent, making it the root of its own tree.
+// It does nothing if the node is already a root.
+func (n *Node[T]) Detach() {
+	if n.parent != nil {
+		n.parent.RemoveChild(n)
+	}
+}
+
+// PreOrder returns an iterator over the subtree in depth-first pre-order:
+// each node is visited before its children.
+func (n *Node[T]) PreOrder() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		n.preOrder(yield)
+	}
+}
+
+func (n *Node[T]) preOrder(yield func(*Node[T]) bool) bool {
+	if !yield(n) {
+		return false
+	}
+	for _, child := range n.children {
+		if !child.preOrder(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// PostOrder returns an iterator over the subtree in depth-first post-order:
+// each node is visited after its children.
+func (n *Node[T]) PostOrder() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		n.postOrder(yield)
+	}
+}
+
+func (n *Node[T]) postOrder(yield func(*Node[T]) bool) bool {
+	for _, child := range n.children {
+		if !child.postOrder(yield) {
+			return false
+		}
+	}
+	return yield(n)
+}
+
+// BreadthFirst returns an iterator over the subtree level by level, starting at this node.
+func (n *Node[T]) BreadthFirst() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		queue := []*Node[T]{n}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			if !yield(node) {
+				return
+			}
+			queue = append(queue, node.children...)
+		}
+	}
+}
+
+// Values returns an iterator over the values of the subtree in depth-first pre-order.
+func (n *Node[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := range n.PreOrder() {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first node in pre-order whose value matches the predicate.
+func (n *Node[T]) Find(predicate func(T) bool) optional.Option[*Node[T]] {
+	for node := range n.PreOrder() {
+		if predicate(node.Value) {
+			return optional.Some(node)
+		}
+	}
+	return optional.None[*Node[T]]()
+}
+
+// Filter returns every node in pre-order whose value matches the predicate.
+func (n *Node[T]) Filter(predicate func(T) bool) []*Node[T] {
+	var nodes []*Node[T]
+	for node := range n.PreOrder() {
+		if predicate(node.Value) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// Flatten returns the values of the subtree as a slice, in depth-first pre-order.
+func (n *Node[T]) Flatten() []T {
+	return slices.Collect(n.Values())
+}
+
+// Map builds a new tree with the same shape, holding the values transformed by fn.
+// The new tree is rooted at the copy of n, regardless of whether n has a parent.
+func Map[T, U any](n *Node[T], fn func(T) U) *Node[U] {
+	mapped := &Node[U]{Value: fn(n.Value)}
+	if len(n.children) > 0 {
+		mapped.children = make([]*Node[U], len(n.children))
+		for i, child := range n.children {
+			c := Map(child, fn)
+			c.parent = mapped
+			mapped.children[i] = c
+		}
+	}
+	return mapped
+}
@@ -0,0 +1,106 @@
+package tree
+
+import (
+	"slices"
+	"testing"
+)
+
+func sample() *Node[string] {
+	root := New("a")
+	b := root.AddChild("b")
+	b.AddChild("d")
+	b.AddChild("e")
+	root.AddChild("c")
+	return root
+}
+
+func values(seq func(func(*Node[string]) bool)) []string {
+	var out []string
+	for n := range seq {
+		out = append(out, n.Value)
+	}
+	return out
+}
+
+func TestTraversals(t *testing.T) {
+	root := sample()
+	if got := values(root.PreOrder()); !slices.Equal(got, []string{"a", "b", "d", "e", "c"}) {
+		t.Errorf("unexpected pre-order: %v", got)
+	}
+	if got := values(root.PostOrder()); !slices.Equal(got, []string{"d", "e", "b", "c", "a"}) {
+		t.Errorf("unexpected post-order: %v", got)
+	}
+	if got := values(root.BreadthFirst()); !slices.Equal(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("unexpected breadth-first order: %v", got)
+	}
+}
+
+func TestTraversalStopsEarly(t *testing.T) {
+	count := 0
+	for range sample().PreOrder() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("expected 2 visited nodes, got %d", count)
+	}
+}
+
+func TestFindAndFilter(t *testing.T) {
+	root := sample()
+	e := root.Find(func(v string) bool { return v == "e" })
+	if !e.IsPresent() {
+		t.Fatal("expected to find e")
+	}
+	if e.Get().Depth() != 2 {
+		t.Errorf("expected depth 2, got %d", e.Get().Depth())
+	}
+	path := values(slices.Values(e.Get().Path()))
+	if !slices.Equal(path, []string{"a", "b", "e"}) {
+		t.Errorf("unexpected path: %v", path)
+	}
+	leaves := root.Filter(func(v string) bool { return v > "c" })
+	if len(leaves) != 2 {
+		t.Errorf("expected 2 matches, got %d", len(leaves))
+	}
+}
+
+func TestRestructure(t *testing.T) {
+	root := sample()
+	b := root.Find(func(v string) bool { return v == "b" }).Get()
+	c := root.Find(func(v string) bool { return v == "c" }).Get()
+
+	c.AppendChild(b)
+	if b.Parent() != c {
+		t.Error("expected b to be moved under c")
+	}
+	if got := root.Flatten(); !slices.Equal(got, []string{"a", "c", "b", "d", "e"}) {
+		t.Errorf("unexpected tree after move: %v", got)
+	}
+
+	b.Detach()
+	if !b.IsRoot() || root.Size() != 2 || b.Size() != 3 {
+		t.Errorf("unexpected sizes after detach: root %d, b %d", root.Size(), b.Size())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic when creating a cycle")
+		}
+	}()
+	b.Children()[0].AppendChild(b)
+}
+
+func TestMap(t *testing.T) {
+	root := sample()
+	mapped := Map(root, func(v string) int { return len(v) })
+	if mapped.Size() != root.Size() {
+		t.Errorf("expected size %d, got %d", root.Size(), mapped.Size())
+	}
+	child := mapped.Children()[0]
+	if child.Parent() != mapped {
+		t.Error("expected mapped child to point to mapped root")
+	}
+}
@@ -0,0 +1,51 @@
+// Package watch provides an observable concurrent map that emits an event for every change.
+//
+// It is built for configuration hot-reload and cache invalidation fan-out: one part of the
+// program writes to the Map, and any number of subscribers are told about each change.
+//
+// # Basic Usage
+//
+//	m := watch.New[string, string]()
+//	defer m.Close()
+//
+//	cancel := m.Subscribe(func(ev watch.Event[string, string]) {
+//	    fmt.Println(ev.Type, ev.Key, ev.Value)
+//	})
+//	defer cancel()
+//
+//	m.Set("log.level", "debug") // Set log.level debug
+//	m.Delete("log.level")       // Delete log.level debug
+//
+// # Subscriptions
+//
+// Subscribers are either callbacks (Subscribe) or channels (Watch):
+//
+//	events, cancel := m.Watch(64)
+//	go func() {
+//	    for ev := range events {
+//	        invalidate(ev.Key)
+//	    }
+//	}()
+//
+// With WithReplay, a new subscriber first receives an EventSet for every key already in the
+// Map, then every later change, with nothing lost or duplicated in between:
+//
+//	m.Subscribe(applyConfig, watch.WithReplay())
+//
+// Events for EventSet carry the replaced value in Old, so subscribers can diff old and new values.
+//
+// # Expiry
+//
+// SetWithTTL removes the key after the given duration and emits an EventExpire.
+// Setting or deleting the key again before then cancels the expiry:
+//
+//	m.SetWithTTL("session:42", token, 30*time.Minute)
+//
+// Expiry runs on a single scheduler goroutine owned by the Map; Close stops it.
+//
+// # Thread Safety
+//
+// Map is safe for concurrent use. Reads do not block on writes. Writes are serialized and
+// subscribers are called synchronously while a write is in progress, so every subscriber sees
+// changes in the same order. A slow subscriber, or a full Watch channel, slows down writers.
+package watch
@@ -0,0 +1,278 @@
+package watch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/marouanesouiri/stdx/cmap"
+	"github.com/marouanesouiri/stdx/optional"
+	"github.com/marouanesouiri/stdx/scheduler"
+)
+
+// EventType identifies the kind of change an Event describes.
+type EventType int
+
+const (
+	// EventSet is emitted when a key is added or its value replaced.
+	EventSet EventType = iota
+	// EventDelete is emitted when a key is removed with Delete.
+	EventDelete
+	// EventExpire is emitted when a key set with SetWithTTL reaches its expiry.
+	EventExpire
+)
+
+// String returns the name of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventSet:
+		return "Set"
+	case EventDelete:
+		return "Delete"
+	case EventExpire:
+		return "Expire"
+	default:
+		return "Unknown"
+	}
+}
+
+// Event describes a single change to a Map.
+type Event[K comparable, V any] struct {
+	Type EventType
+	Key  K
+	// Value is the new value for EventSet, and the removed value for EventDelete and EventExpire.
+	Value V
+	// Old is the value replaced by an EventSet, or None if the key was new.
+	Old optional.Option[V]
+}
+
+type entry[V any] struct {
+	value V
+	gen   uint64
+}
+
+// Map is a concurrent map that notifies subscribers of every change.
+//
+// Reads go straight to the underlying cmap.ConcurrentMap. Writes are serialized so that
+// every subscriber observes the changes in the same order they were applied.
+// Subscribers are called synchronously while the write is in progress, so they must be
+// fast and must not modify the Map themselves.
+type Map[K comparable, V any] struct {
+	data  cmap.ConcurrentMap[K, entry[V]]
+	sched *scheduler.Scheduler
+
+	mu      sync.Mutex
+	gen     uint64
+	subs    map[uint64]func(Event[K, V])
+	nextSub uint64
+	closed  bool
+}
+
+// New creates an empty Map.
+// Call Close when the Map is no longer needed to release the expiry scheduler.
+func New[K comparable, V any]() *Map[K, V] {
+	m := &Map[K, V]{
+		data:  cmap.New[K, entry[V]](),
+		sched: scheduler.New(),
+		subs:  make(map[uint64]func(Event[K, V])),
+	}
+	m.sched.Start()
+	return m
+}
+
+// Get returns the value for key, or None if the key is absent.
+func (m *Map[K, V]) Get(key K) optional.Option[V] {
+	e, ok := m.data.Get(key).Unpack()
+	if !ok {
+		return optional.None[V]()
+	}
+	return optional.Some(e.value)
+}
+
+// Has returns true if key is present.
+func (m *Map[K, V]) Has(key K) bool {
+	return m.data.Has(key)
+}
+
+// Len returns the number of keys in the Map.
+func (m *Map[K, V]) Len() int {
+	return m.data.Len()
+}
+
+// Range calls fn for each key-value pair. If fn returns false, iteration stops.
+func (m *Map[K, V]) Range(fn func(key K, value V) bool) {
+	m.data.Range(func(key K, e entry[V]) bool {
+		return fn(key, e.value)
+	})
+}
+
+// Set stores value under key and emits an EventSet.
+// Any expiry previously set for key is cleared.
+func (m *Map[K, V]) Set(key K, value V) {
+	m.set(key, value, 0)
+}
+
+// SetWithTTL stores value under key and emits an EventSet.
+// Once ttl has elapsed, the key is removed and an EventExpire is emitted,
+// unless the key was set or deleted again in the meantime.
+// A non-positive ttl behaves like Set.
+func (m *Map[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	m.set(key, value, ttl)
+}
+
+func (m *Map[K, V]) set(key K, value V, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.gen++
+	gen := m.gen
+	var old optional.Option[V]
+	if e, ok := m.data.Get(key).Unpack(); ok {
+		old = optional.Some(e.value)
+	}
+	m.data.Set(key, entry[V]{value: value, gen: gen})
+	m.emit(Event[K, V]{Type: EventSet, Key: key, Value: value, Old: old})
+
+	if ttl > 0 && !m.closed {
+		m.sched.Schedule(ttl, func() {
+			m.expire(key, gen)
+		})
+	}
+}
+
+// Delete removes key and emits an EventDelete.
+// Returns true if the key was present.
+func (m *Map[K, V]) Delete(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.data.Remove(key).Unpack()
+	if !ok {
+		return false
+	}
+	m.emit(Event[K, V]{Type: EventDelete, Key: key, Value: e.value})
+	return true
+}
+
+// expire removes key if it still holds the value written with gen.
+func (m *Map[K, V]) expire(key K, gen uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.data.Get(key).Unpack()
+	if !ok || e.gen != gen {
+		return
+	}
+	m.data.Delete(key)
+	m.emit(Event[K, V]{Type: EventExpire, Key: key, Value: e.value})
+}
+
+// emit calls every subscriber with ev. The caller must hold m.mu.
+func (m *Map[K, V]) emit(ev Event[K, V]) {
+	for _, fn := range m.subs {
+		fn(ev)
+	}
+}
+
+// SubscribeOption configures a subscription.
+type SubscribeOption func(*subscribeConfig)
+
+type subscribeConfig struct {
+	replay bool
+}
+
+// WithReplay makes a new subscriber first receive an EventSet for every key currently
+// in the Map, before any live event. No change can happen in between, so the subscriber
+// sees a consistent view of the Map followed by every later change.
+func WithReplay() SubscribeOption {
+	return func(c *subscribeConfig) {
+		c.replay = true
+	}
+}
+
+// Subscribe registers fn to be called for every change and returns a function that cancels the subscription.
+// fn is called synchronously by the goroutine making the change and must not modify the Map.
+func (m *Map[K, V]) Subscribe(fn func(Event[K, V]), opts ...SubscribeOption) (cancel func()) {
+	cfg := newSubscribeConfig(opts)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cfg.replay {
+		m.replay(fn)
+	}
+	return m.addSubscriber(fn)
+}
+
+// Watch returns a channel receiving every change, and a function that cancels the
+// subscription and closes the channel.
+//
+// Events are delivered in order and never dropped: when the channel buffer is full,
+// writers to the Map block until the receiver catches up. Choose buffer accordingly,
+// and do not call cancel from the goroutine draining the channel while a write may be blocked on it.
+// Replayed events are always buffered in full, on top of buffer.
+func (m *Map[K, V]) Watch(buffer int, opts ...SubscribeOption) (<-chan Event[K, V], func()) {
+	cfg := newSubscribeConfig(opts)
+
+	m.mu.Lock()
+	if cfg.replay {
+		buffer += m.data.Len()
+	}
+	ch := make(chan Event[K, V], buffer)
+	send := func(ev Event[K, V]) {
+		ch <- ev
+	}
+	if cfg.replay {
+		m.replay(send)
+	}
+	cancelSub := m.addSubscriber(send)
+	m.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			cancelSub()
+			close(ch)
+		})
+	}
+}
+
+func newSubscribeConfig(opts []SubscribeOption) subscribeConfig {
+	var cfg subscribeConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// replay calls fn with an EventSet for every current key. The caller must hold m.mu.
+func (m *Map[K, V]) replay(fn func(Event[K, V])) {
+	m.data.Range(func(key K, e entry[V]) bool {
+		fn(Event[K, V]{Type: EventSet, Key: key, Value: e.value})
+		return true
+	})
+}
+
+// addSubscriber registers fn and returns the function removing it. The caller must hold m.mu.
+func (m *Map[K, V]) addSubscriber(fn func(Event[K, V])) func() {
+	m.nextSub++
+	id := m.nextSub
+	m.subs[id] = fn
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.mu.Lock()
+			delete(m.subs, id)
+			m.mu.Unlock()
+		})
+	}
+}
+
+// Close stops the expiry scheduler. Keys set with a TTL no longer expire afterwards.
+// The Map remains usable for reads and writes.
+func (m *Map[K, V]) Close() {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+	m.sched.Stop()
+}
@@ -0,0 +1,97 @@
+package watch
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSubscribe(t *testing.T) {
+	m := New[string, int]()
+	defer m.Close()
+
+	var events []Event[string, int]
+	cancel := m.Subscribe(func(ev Event[string, int]) {
+		events = append(events, ev)
+	})
+
+	m.Set("a", 1)
+	m.Set("a", 2)
+	m.Delete("a")
+	m.Delete("missing")
+	cancel()
+	m.Set("b", 3)
+
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	if events[0].Type != EventSet || events[0].Old.IsPresent() {
+		t.Errorf("expected first Set without old value, got %+v", events[0])
+	}
+	if events[1].Type != EventSet || events[1].Old.Get() != 1 || events[1].Value != 2 {
+		t.Errorf("expected Set replacing 1 with 2, got %+v", events[1])
+	}
+	if events[2].Type != EventDelete || events[2].Value != 2 {
+		t.Errorf("expected Delete of 2, got %+v", events[2])
+	}
+}
+
+func TestWatchReplay(t *testing.T) {
+	m := New[int, int]()
+	defer m.Close()
+	for i := range 10 {
+		m.Set(i, i)
+	}
+
+	ch, cancel := m.Watch(0, WithReplay())
+	for range 10 {
+		if ev := <-ch; ev.Type != EventSet {
+			t.Errorf("expected replayed Set, got %v", ev.Type)
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		m.Delete(3)
+	}()
+	if ev := <-ch; ev.Type != EventDelete || ev.Key != 3 {
+		t.Errorf("expected Delete of key 3, got %+v", ev)
+	}
+	wg.Wait()
+
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed after cancel")
+	}
+}
+
+func TestExpire(t *testing.T) {
+	m := New[string, string]()
+	defer m.Close()
+
+	expired := make(chan Event[string, string], 1)
+	m.Subscribe(func(ev Event[string, string]) {
+		if ev.Type == EventExpire {
+			expired <- ev
+		}
+	})
+
+	m.SetWithTTL("session", "x", 20*time.Millisecond)
+	m.SetWithTTL("renewed", "y", 20*time.Millisecond)
+	m.Set("renewed", "z")
+
+	select {
+	case ev := <-expired:
+		if ev.Key != "session" {
+			t.Errorf("expected session to expire, got %s", ev.Key)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected an Expire event")
+	}
+	time.Sleep(50 * time.Millisecond)
+	if m.Has("session") || !m.Has("renewed") {
+		t.Error("expected only the session key to be removed")
+	}
+}
@@ -0,0 +1,26 @@
+// Package wrand provides weighted random selection.
+//
+// A Selector holds items with non-negative weights and picks one at random, each with a
+// probability proportional to its weight. Both picking and changing a weight are O(log n),
+// so weights can follow live signals such as backend health or capacity:
+//
+//	backends := wrand.New[string]()
+//	backends.Set("10.0.0.1", 3)
+//	backends.Set("10.0.0.2", 1)
+//
+//	addr := backends.Pick().MustGet() // "10.0.0.1" three times as often as "10.0.0.2"
+//
+//	// Drain a backend without forgetting it
+//	backends.Set("10.0.0.2", 0)
+//
+// # Deterministic Seeding
+//
+// By default each Selector uses its own randomly seeded source. WithSeed makes the sequence
+// of picks reproducible, which helps in tests and simulations:
+//
+//	s := wrand.New[string](wrand.WithSeed(42))
+//
+// # Thread Safety
+//
+// A Selector is safe for concurrent use; its methods are serialized by a mutex.
+package wrand
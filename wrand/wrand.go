@@ -0,0 +1,215 @@
+package wrand
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+	"sync"
+
+	"github.com/marouanesouiri/stdx/optional"
+)
+
+// Selector picks items at random, each with a probability proportional to its weight.
+// Selection and weight updates are O(log n), backed by a Fenwick tree of prefix sums.
+// It is safe for concurrent use.
+type Selector[T comparable] struct {
+	mu      sync.Mutex
+	rng     *rand.Rand
+	items   []T
+	weights []float64
+	index   map[T]int
+	// tree is a 1-based Fenwick tree over weights: tree[i] holds the sum of the
+	// weights in (i-lowbit(i), i].
+	tree []float64
+	// updates counts incremental tree updates since the last rebuild. The tree is rebuilt
+	// from the weights every len(items) updates so that floating-point error cannot build up.
+	updates int
+}
+
+// Option configures a Selector.
+type Option func(*config)
+
+type config struct {
+	rng *rand.Rand
+}
+
+// WithSeed makes the selection sequence deterministic: two selectors created with the same
+// seed and given the same operations pick the same items.
+func WithSeed(seed int64) Option {
+	return func(c *config) {
+		c.rng = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithRand sets the random source used for selection. The Selector serializes its use,
+// but the source must not be used elsewhere concurrently.
+func WithRand(rng *rand.Rand) Option {
+	return func(c *config) {
+		c.rng = rng
+	}
+}
+
+// New creates an empty Selector. Without WithSeed or WithRand, it uses a randomly seeded source.
+func New[T comparable](opts ...Option) *Selector[T] {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.rng == nil {
+		cfg.rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+	return &Selector[T]{
+		rng:   cfg.rng,
+		index: make(map[T]int),
+		tree:  []float64{0},
+	}
+}
+
+// Set adds item with the given weight, or updates its weight if it is already present.
+// An item with weight 0 stays registered but is never picked.
+// Panics if weight is negative, infinite or NaN.
+func (s *Selector[T]) Set(item T, weight float64) {
+	if !(weight >= 0) || math.IsInf(weight, 1) {
+		panic("wrand: weight must be a finite non-negative number")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i, ok := s.index[item]; ok {
+		delta := weight - s.weights[i]
+		s.weights[i] = weight
+		s.add(i, delta)
+		return
+	}
+	s.index[item] = len(s.items)
+	s.items = append(s.items, item)
+	s.weights = append(s.weights, weight)
+	n := len(s.items)
+	// The new node covers (n-lowbit(n), n]: its own weight plus the weights before it in that range.
+	s.tree = append(s.tree, weight+s.prefix(n-1)-s.prefix(n-lowbit(n)))
+}
+
+// Remove unregisters item. It returns false if the item was not present.
+func (s *Selector[T]) Remove(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.index[item]
+	if !ok {
+		return false
+	}
+	last := len(s.items) - 1
+	if i != last {
+		// Move the last item into the freed slot so the tree stays dense.
+		delta := s.weights[last] - s.weights[i]
+		s.items[i] = s.items[last]
+		s.weights[i] = s.weights[last]
+		s.index[s.items[i]] = i
+		s.add(i, delta)
+	}
+	delete(s.index, item)
+	var zero T
+	s.items[last] = zero
+	s.items = s.items[:last]
+	s.weights = s.weights[:last]
+	// The last tree node covers no other index, so it can simply be dropped.
+	s.tree = s.tree[:last+1]
+	return true
+}
+
+// Weight returns the weight of item, or None if it is not registered.
+func (s *Selector[T]) Weight(item T) optional.Option[float64] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[item]
+	if !ok {
+		return optional.None[float64]()
+	}
+	return optional.Some(s.weights[i])
+}
+
+// Len returns the number of registered items, including those with weight 0.
+func (s *Selector[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+// Total returns the sum of all weights.
+func (s *Selector[T]) Total() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.prefix(len(s.items))
+}
+
+// Pick returns a random item, chosen with a probability proportional to its weight,
+// or None if no item has a positive weight.
+func (s *Selector[T]) Pick() optional.Option[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := len(s.items)
+	total := s.prefix(n)
+	if total <= 0 {
+		return optional.None[T]()
+	}
+	target := s.rng.Float64() * total
+
+	// Descend the tree to find the first index whose prefix sum exceeds target.
+	pos := 0
+	for step := 1 << (bits.Len(uint(n)) - 1); step > 0; step >>= 1 {
+		if next := pos + step; next <= n && s.tree[next] <= target {
+			pos = next
+			target -= s.tree[next]
+		}
+	}
+	// Rounding can push pos past the end or onto a zero weight; fall back to the nearest
+	// item with a positive weight before it.
+	for pos >= n || s.weights[pos] == 0 {
+		pos--
+		if pos < 0 {
+			return optional.None[T]()
+		}
+	}
+	return optional.Some(s.items[pos])
+}
+
+// add propagates a change of delta to the weight at 0-based index i, which must already
+// be stored in weights, to the tree.
+func (s *Selector[T]) add(i int, delta float64) {
+	n := len(s.items)
+	for j := i + 1; j <= n; j += lowbit(j) {
+		s.tree[j] += delta
+	}
+	s.updates++
+	if s.updates > n {
+		s.rebuild()
+	}
+}
+
+// prefix returns the sum of the first n weights.
+func (s *Selector[T]) prefix(n int) float64 {
+	var sum float64
+	for j := n; j > 0; j -= lowbit(j) {
+		sum += s.tree[j]
+	}
+	return sum
+}
+
+// rebuild recomputes the tree from the weights in O(n).
+func (s *Selector[T]) rebuild() {
+	n := len(s.items)
+	for j := 1; j <= n; j++ {
+		s.tree[j] = s.weights[j-1]
+	}
+	for j := 1; j <= n; j++ {
+		if parent := j + lowbit(j); parent <= n {
+			s.tree[parent] += s.tree[j]
+		}
+	}
+	s.updates = 0
+}
+
+func lowbit(i int) int {
+	return i & -i
+}
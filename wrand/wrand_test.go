@@ -0,0 +1,141 @@
+package wrand
+
+import (
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestSelectorDistribution(t *testing.T) {
+	s := New[string](WithSeed(1))
+	s.Set("a", 1)
+	s.Set("b", 3)
+	s.Set("c", 6)
+	s.Set("zero", 0)
+
+	counts := make(map[string]int)
+	const picks = 100000
+	for range picks {
+		counts[s.Pick().MustGet()]++
+	}
+	if counts["zero"] != 0 {
+		t.Errorf("expected zero-weight item never to be picked, got %d", counts["zero"])
+	}
+	for item, weight := range map[string]float64{"a": 0.1, "b": 0.3, "c": 0.6} {
+		if got := float64(counts[item]) / picks; math.Abs(got-weight) > 0.01 {
+			t.Errorf("expected %s picked with frequency %.2f, got %.3f", item, weight, got)
+		}
+	}
+}
+
+func TestSelectorUpdates(t *testing.T) {
+	s := New[int](WithSeed(1))
+	if s.Pick().IsPresent() {
+		t.Error("expected no pick from an empty selector")
+	}
+	for i := range 100 {
+		s.Set(i, float64(i))
+	}
+	if s.Total() != 4950 {
+		t.Errorf("expected total 4950, got %v", s.Total())
+	}
+
+	for i := range 99 {
+		s.Remove(i)
+	}
+	if s.Len() != 1 || s.Total() != 99 {
+		t.Errorf("expected one item of weight 99, got %d items totalling %v", s.Len(), s.Total())
+	}
+	for range 100 {
+		if got := s.Pick().MustGet(); got != 99 {
+			t.Fatalf("expected 99 as the only item, got %d", got)
+		}
+	}
+	if s.Remove(5) {
+		t.Error("expected Remove of a missing item to return false")
+	}
+
+	s.Set(99, 0)
+	if s.Pick().IsPresent() {
+		t.Error("expected no pick when all weights are zero")
+	}
+	if w := s.Weight(99); !w.IsPresent() || w.Get() != 0 {
+		t.Errorf("expected weight 0 for 99, got %v", w)
+	}
+	if s.Weight(1).IsPresent() {
+		t.Error("expected no weight for a removed item")
+	}
+}
+
+func TestSelectorDeterministic(t *testing.T) {
+	pick := func() []string {
+		s := New[string](WithSeed(7))
+		s.Set("x", 1)
+		s.Set("y", 2)
+		s.Set("z", 3)
+		picked := make([]string, 20)
+		for i := range picked {
+			picked[i] = s.Pick().MustGet()
+		}
+		return picked
+	}
+	first, second := pick(), pick()
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("expected identical sequences with the same seed, got %v and %v", first, second)
+		}
+	}
+}
+
+func TestSelectorInvalidWeight(t *testing.T) {
+	for _, weight := range []float64{-1, math.NaN(), math.Inf(1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for weight %v", weight)
+				}
+			}()
+			New[int]().Set(1, weight)
+		}()
+	}
+}
+
+func TestSelectorConcurrent(t *testing.T) {
+	s := New[int]()
+	var wg sync.WaitGroup
+	for g := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				s.Set(g*1000+i%50, float64(i%7))
+				s.Pick()
+				if i%3 == 0 {
+					s.Remove(g*1000 + i%50)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var sum float64
+	for g := range 4 {
+		for i := range 50 {
+			sum += s.Weight(g*1000 + i).OrElse(0)
+		}
+	}
+	if math.Abs(sum-s.Total()) > 1e-9 {
+		t.Errorf("expected total %v to match the sum of weights %v", s.Total(), sum)
+	}
+}
+
+func BenchmarkSelectorPick(b *testing.B) {
+	s := New[int](WithSeed(1))
+	for i := range 10000 {
+		s.Set(i, float64(i%100+1))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Pick()
+	}
+}